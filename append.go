@@ -0,0 +1,191 @@
+package stringcases
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	AppendSnake          = s.AppendSnake
+	AppendKebab          = s.AppendKebab
+	AppendScreamingSnake = s.AppendScreamingSnake
+	AppendNoCase         = s.AppendNoCase
+	AppendCamel          = s.AppendCamel
+	AppendCamelLower     = s.AppendCamelLower
+	AppendPascal         = s.AppendPascal
+	AppendPascalStrict   = s.AppendPascalStrict
+	AppendHeader         = s.AppendHeader
+	AppendTitle          = s.AppendTitle
+	AppendSentence       = s.AppendSentence
+	AppendHuman          = s.AppendHuman
+	AppendDelimited      = s.AppendDelimited
+	AppendDelimitedUpper = s.AppendDelimitedUpper
+	AppendFormat         = s.AppendFormat
+	AppendInitials       = s.AppendInitials
+	AppendFromHuman      = s.AppendFromHuman
+)
+
+// AppendSnake appends the snake case form of s to dst and returns the
+// extended buffer, like strconv.AppendInt, so loops that generate code can
+// reuse one buffer, e.g.
+//
+//	buf = stringcases.AppendSnake(buf[:0], "userID") // "user_id"
+func (str *String) AppendSnake(dst []byte, s string) []byte {
+	return str.AppendDelimited(dst, s, "_")
+}
+
+// AppendKebab is like ToKebab, but appends to dst, see AppendSnake.
+func (str *String) AppendKebab(dst []byte, s string) []byte {
+	return str.AppendDelimited(dst, s, "-")
+}
+
+// AppendScreamingSnake is like ToScreamingSnake, but appends to dst, see
+// AppendSnake.
+func (str *String) AppendScreamingSnake(dst []byte, s string) []byte {
+	return str.AppendDelimitedUpper(dst, s, "_")
+}
+
+// AppendNoCase is like ToNoCase, but appends to dst, see AppendSnake.
+func (str *String) AppendNoCase(dst []byte, s string) []byte {
+	return str.AppendDelimited(dst, s, " ")
+}
+
+// AppendDelimited is like ToDelimited, but appends to dst, see AppendSnake.
+func (str *String) AppendDelimited(dst []byte, s, sep string) []byte {
+	return str.appendFormat(dst, s, Format{Separator: sep})
+}
+
+// AppendDelimitedUpper is like ToDelimitedUpper, but appends to dst, see
+// AppendSnake.
+func (str *String) AppendDelimitedUpper(dst []byte, s, sep string) []byte {
+	return str.appendFormat(dst, s, Format{Separator: sep, First: WordUpper, Rest: WordUpper})
+}
+
+// AppendCamel is like ToCamel, but appends to dst, see AppendSnake.
+func (str *String) AppendCamel(dst []byte, s string) []byte {
+	return str.appendFormat(dst, s, Format{First: WordLower, Rest: WordTitle, Initialisms: TitleInitialisms})
+}
+
+// AppendCamelLower is like ToCamelLower, but appends to dst, see
+// AppendSnake.
+func (str *String) AppendCamelLower(dst []byte, s string) []byte {
+	return str.appendFormat(dst, s, Format{First: WordLower, Rest: WordTitle})
+}
+
+// AppendPascal is like ToPascal, but appends to dst, see AppendSnake.
+func (str *String) AppendPascal(dst []byte, s string) []byte {
+	return str.appendFormat(dst, s, Format{First: WordTitle, Rest: WordTitle, Initialisms: TitleInitialisms})
+}
+
+// AppendPascalStrict is like ToPascalStrict, but appends to dst, see
+// AppendSnake.
+func (str *String) AppendPascalStrict(dst []byte, s string) []byte {
+	return str.appendFormat(dst, s, Format{First: WordTitle, Rest: WordTitle})
+}
+
+// AppendHeader is like ToHeader, but appends to dst, see AppendSnake.
+func (str *String) AppendHeader(dst []byte, s string) []byte {
+	return str.appendFormat(dst, s, Format{Separator: "-", First: WordTitle, Rest: WordTitle, Initialisms: TitleInitialisms})
+}
+
+// AppendTitle is like ToTitle, but appends to dst, see AppendSnake.
+func (str *String) AppendTitle(dst []byte, s string) []byte {
+	tokens := str.tokenize(s)
+	if str.maxWords > 0 && len(tokens) > str.maxWords {
+		tokens = tokens[:str.maxWords]
+	}
+
+	for i, token := range tokens {
+		if i > 0 {
+			dst = append(dst, ' ')
+		}
+
+		w := str.lower(token)
+		if i == 0 || i == len(tokens)-1 || !str.minorWords[w] {
+			w = str.caseWord(token, WordTitle, TitleInitialisms)
+		}
+		if str.tokenHook != nil {
+			w = str.tokenHook(i, w)
+		}
+		dst = append(dst, w...)
+	}
+
+	return dst
+}
+
+// AppendSentence is like ToSentence, but appends to dst, see AppendSnake.
+func (str *String) AppendSentence(dst []byte, s string) []byte {
+	return str.appendFormat(dst, s, Format{Separator: " ", First: WordTitle, Rest: WordLower, Initialisms: AllInitialisms})
+}
+
+// AppendHuman is like ToHuman, but appends to dst, see AppendSnake.
+func (str *String) AppendHuman(dst []byte, s string) []byte {
+	if str.contractions != ContractionIgnore {
+		s = removeApostrophes(s)
+	}
+
+	tokens := str.tokenize(s)
+	if n := len(tokens); n > 1 && strings.EqualFold(tokens[n-1], "id") {
+		tokens = tokens[:n-1]
+	}
+
+	start := len(dst)
+	dst = str.AppendSentence(dst, strings.Join(tokens, " "))
+	if str.contractions != ContractionIgnore {
+		dst = append(dst[:start], str.contract(string(dst[start:]))...)
+	}
+
+	return dst
+}
+
+// AppendFromHuman is like FromHuman, but appends to dst, see AppendSnake.
+func (str *String) AppendFromHuman(dst []byte, s string) []byte {
+	return str.AppendSnake(dst, removeApostrophes(s))
+}
+
+// AppendInitials is like ToInitials, but appends to dst, see AppendSnake.
+func (str *String) AppendInitials(dst []byte, s string, opts InitialsOptions) []byte {
+	n := 0
+	for _, token := range str.tokenize(s) {
+		r, size := utf8.DecodeRuneInString(token)
+		if size == 0 {
+			continue
+		}
+
+		if unicode.IsNumber(r) {
+			if !opts.KeepDigits {
+				continue
+			}
+
+			for _, d := range token {
+				if !unicode.IsNumber(d) {
+					break
+				}
+				dst = utf8.AppendRune(dst, d)
+				n++
+			}
+
+			continue
+		}
+
+		for _, u := range str.upper(token[:size]) {
+			dst = utf8.AppendRune(dst, u)
+			n++
+		}
+	}
+
+	// Cut the initials to MaxLength runes.
+	for ; opts.MaxLength > 0 && n > opts.MaxLength; n-- {
+		_, size := utf8.DecodeLastRune(dst)
+		dst = dst[:len(dst)-size]
+	}
+
+	return dst
+}
+
+// AppendFormat is like the converter returned by Formatter, but appends to
+// dst, see AppendSnake.
+func (str *String) AppendFormat(dst []byte, s string, f Format) []byte {
+	return str.appendFormat(dst, s, f)
+}
@@ -0,0 +1,153 @@
+package stringcases_test
+
+import (
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestAppend(t *testing.T) {
+	tests := []struct {
+		name   string
+		append func([]byte, string) []byte
+		to     func(string) string
+	}{
+		{"snake", stringcases.AppendSnake, stringcases.ToSnake},
+		{"kebab", stringcases.AppendKebab, stringcases.ToKebab},
+		{"screaming snake", stringcases.AppendScreamingSnake, stringcases.ToScreamingSnake},
+		{"no case", stringcases.AppendNoCase, stringcases.ToNoCase},
+		{"camel", stringcases.AppendCamel, stringcases.ToCamel},
+		{"camel lower", stringcases.AppendCamelLower, stringcases.ToCamelLower},
+		{"pascal", stringcases.AppendPascal, stringcases.ToPascal},
+		{"pascal strict", stringcases.AppendPascalStrict, stringcases.ToPascalStrict},
+		{"header", stringcases.AppendHeader, stringcases.ToHeader},
+		{"title", stringcases.AppendTitle, stringcases.ToTitle},
+		{"sentence", stringcases.AppendSentence, stringcases.ToSentence},
+		{"human", stringcases.AppendHuman, stringcases.ToHuman},
+		{"from human", stringcases.AppendFromHuman, stringcases.FromHuman},
+		{"slug", stringcases.AppendSlug, stringcases.ToSlug},
+		{"env", stringcases.AppendEnv, stringcases.ToEnv},
+		{"prometheus metric", stringcases.AppendPrometheusMetric, stringcases.ToPrometheusMetric},
+		{"prometheus label", stringcases.AppendPrometheusLabel, stringcases.ToPrometheusLabel},
+		{"go exported", stringcases.AppendGoExported, stringcases.ToGoExported},
+		{"go unexported", stringcases.AppendGoUnexported, stringcases.ToGoUnexported},
+		{"package name", stringcases.AppendPackageName, stringcases.ToPackageName},
+		{"slug n", func(dst []byte, s string) []byte {
+			return stringcases.AppendSlugN(dst, s, 12)
+		}, func(s string) string {
+			return stringcases.ToSlugN(s, 12)
+		}},
+		{"filename", func(dst []byte, s string) []byte {
+			return stringcases.AppendFilename(dst, s, "_")
+		}, func(s string) string {
+			return stringcases.ToFilename(s, "_")
+		}},
+		{"initials", func(dst []byte, s string) []byte {
+			return stringcases.AppendInitials(dst, s, stringcases.InitialsOptions{KeepDigits: true, MaxLength: 3})
+		}, func(s string) string {
+			return stringcases.ToInitials(s, stringcases.InitialsOptions{KeepDigits: true, MaxLength: 3})
+		}},
+		{"otel attr", func(dst []byte, s string) []byte {
+			return stringcases.AppendOTelAttr(dst, "http..request", s)
+		}, func(s string) string {
+			return stringcases.ToOTelAttr("http..request", s)
+		}},
+		{"proto enum value", func(dst []byte, s string) []byte {
+			return stringcases.AppendProtoEnumValue(dst, "UserStatus", s)
+		}, func(s string) string {
+			return stringcases.ToProtoEnumValue("UserStatus", s)
+		}},
+		{"sql identifier", func(dst []byte, s string) []byte {
+			return stringcases.AppendSQLIdentifier(dst, s, stringcases.MSSQL)
+		}, func(s string) string {
+			return stringcases.ToSQLIdentifier(s, stringcases.MSSQL)
+		}},
+		{"bem", func(dst []byte, s string) []byte {
+			return stringcases.AppendBEM(dst, "searchForm", s, s)
+		}, func(s string) string {
+			return stringcases.BEM("searchForm", s, s)
+		}},
+	}
+
+	texts := []string{"userAPIKey", "the lord of the rings", "author_id", "Übergröße", "", "2fa code", "type",
+		"user_status_active", "Quarterly Report.PDF", "con", "order", "café/menu: 3 items"}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, s := range texts {
+				assert.Equal(t, "> "+tc.to(s), string(tc.append([]byte("> "), s)), s)
+			}
+		})
+	}
+
+	fallible := []struct {
+		name   string
+		append func([]byte, string) ([]byte, error)
+		to     func(string) (string, error)
+	}{
+		{"dns label", stringcases.AppendDNSLabel, stringcases.ToDNSLabel},
+		{"docker repository", stringcases.AppendDockerRepository, stringcases.ToDockerRepository},
+		{"docker tag", stringcases.AppendDockerTag, stringcases.ToDockerTag},
+		{"bucket name", stringcases.AppendBucketName, stringcases.ToBucketName},
+		{"css var", stringcases.AppendCSSVar, stringcases.ToCSSVar},
+		{"macro", func(dst []byte, s string) ([]byte, error) {
+			return stringcases.AppendMacro(dst, "myapp", s)
+		}, func(s string) (string, error) {
+			return stringcases.ToMacro("myapp", s)
+		}},
+		{"env with prefix", func(dst []byte, s string) ([]byte, error) {
+			return stringcases.AppendEnvWithPrefix(dst, "myapp", s)
+		}, func(s string) (string, error) {
+			return stringcases.EnvWithPrefix("myapp", s)
+		}},
+	}
+
+	for _, tc := range fallible {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			for _, s := range append(texts, "-/MyOrg//User Service-/", "ab", "a:b") {
+				want, wantErr := tc.to(s)
+				got, err := tc.append([]byte("> "), s)
+				assert.Equal(wantErr, err, s)
+				assert.Equal("> "+want, string(got), s)
+			}
+		})
+	}
+
+	t.Run("delimited", func(t *testing.T) {
+		assert := assert.New(t)
+		assert.Equal("user::api", string(stringcases.AppendDelimited(nil, "userAPI", "::")))
+		assert.Equal("USER.API", string(stringcases.AppendDelimitedUpper(nil, "userAPI", ".")))
+	})
+
+	t.Run("format", func(t *testing.T) {
+		f := stringcases.Format{Separator: ".", First: stringcases.WordTitle, Rest: stringcases.WordTitle}
+		assert.Equal(t, "User.Api", string(stringcases.AppendFormat(nil, "userAPI", f)))
+	})
+
+	t.Run("options", func(t *testing.T) {
+		str := stringcases.New(language.English,
+			stringcases.WithUnderscores(),
+			stringcases.WithMaxLength(12, stringcases.TruncateWords),
+		)
+		assert.Equal(t, str.ToSnake("__userAccountSettings__"), string(str.AppendSnake(nil, "__userAccountSettings__")))
+	})
+
+	t.Run("reuse", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		buf = stringcases.AppendSnake(buf[:0], "userID")
+		assert.Equal(t, "user_id", string(buf))
+		buf = stringcases.AppendSnake(buf[:0], "HTTPServer")
+		assert.Equal(t, "http_server", string(buf))
+	})
+}
+
+func BenchmarkAppendSnake(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = stringcases.AppendSnake(buf[:0], "userAccountHTTPSettingsID")
+	}
+}
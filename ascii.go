@@ -0,0 +1,302 @@
+package stringcases
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// asciiScan reports whether pure ASCII input can be tokenized by byte
+// offsets, see appendASCII. The options that rewrite words or look beyond
+// letters and digits need the general tokenizer.
+func (str *String) asciiScan() bool {
+	return str.fastASCII && str.tokenizer == nil && str.separators == nil &&
+		str.symbols != SymbolReplace && str.symbols != SymbolKeep &&
+		!str.delimiters && !str.patterns && str.numbers != NumberUnits &&
+		len(str.expansions) == 0 && len(str.abbreviations) == 0 &&
+		str.tokenHook == nil && !str.minimal && str.ambiguity != AmbiguityMark
+}
+
+// appendASCII is like appendFormat for the pure ASCII input s, which is
+// tokenized and cased in place, so the words are never copied into strings
+// of their own. The words are the same as those of scan.
+func (str *String) appendASCII(dst []byte, s string, f Format, romans bool) []byte {
+	for i, n := 0, 0; str.maxWords == 0 || n < str.maxWords; n++ {
+		start, end := str.nextASCII(s, i)
+		if start < 0 {
+			break
+		}
+
+		if n > 0 {
+			dst = append(dst, f.Separator...)
+		}
+		dst = str.appendASCIIToken(dst, n, s[start:end], f, romans)
+		i = end
+	}
+
+	return dst
+}
+
+// nextASCII returns the byte offsets of the first word of s at or after i,
+// or -1 when there is none. It follows scan and the extract methods.
+func (str *String) nextASCII(s string, i int) (int, int) {
+	for ; i < len(s); i++ {
+		if n, _ := str.matchWord(s[i:]); n > 0 {
+			return i, i + n
+		}
+
+		switch c := s[i]; {
+		case isLowerASCII(c), isDigitASCII(c):
+			return i, str.asciiLowerEnd(s, i, i+1)
+		case isUpperASCII(c):
+			return i, str.asciiUpperEnd(s, i)
+		}
+	}
+
+	return -1, -1
+}
+
+// asciiLowerEnd continues the word s[i:j] like extractLower and extractCamel.
+func (str *String) asciiLowerEnd(s string, i, j int) int {
+	for ; j < len(s); j++ {
+		c := s[j]
+		if isUpperASCII(c) || str.splitNumberASCII(s[i:j], c) || !isLowerASCII(c) && !isDigitASCII(c) {
+			break
+		}
+	}
+
+	return j
+}
+
+// asciiUpperEnd continues the word that starts with the uppercase letter at
+// i like extractUpper.
+func (str *String) asciiUpperEnd(s string, i int) int {
+	j := i + 1
+	if j == len(s) {
+		return j
+	}
+
+	switch c := s[j]; {
+	case isUpperASCII(c):
+		return str.asciiInitialismEnd(s, i, j+1)
+	case isDigitASCII(c) && str.splitNumberASCII(s[i:j], c):
+		return j
+	case isLowerASCII(c), isDigitASCII(c):
+		return str.asciiLowerEnd(s, i, j+1)
+	default:
+		return j
+	}
+}
+
+// asciiInitialismEnd continues the run of uppercase letters s[i:j] like
+// extractCommonInitialism.
+func (str *String) asciiInitialismEnd(s string, i, j int) int {
+	for j < len(s) && isUpperASCII(s[j]) {
+		j++
+	}
+
+	var next, after byte
+	if j < len(s) {
+		next = s[j]
+	}
+	if j+1 < len(s) {
+		after = s[j+1]
+	}
+
+	set := str.initialisms.Load()
+	run := s[i:j]
+	end := j
+	switch {
+	case next == 's' && !isLowerASCII(after) && !isDigitASCII(after) && set.endsWithInitialismString(run):
+		if set.longestPrefixString(run) == len(run) {
+			return j + 1
+		}
+	case isLowerASCII(next) && str.acronymBoundary == AcronymBoundaryLastUpper:
+		end = j - 1
+	case isLowerASCII(next) && set.longestPrefixString(run[:len(run)-1]) > 0:
+		end = j - 1
+	case isLowerASCII(next) && set.longestPrefixString(run) > 0:
+	case isLowerASCII(next) && str.acronyms && len(run) > 2:
+		end = j - 1
+	case isLowerASCII(next) && str.romans && len(run) > 2 && isRomanNumeral(run[:len(run)-1]):
+		end = j - 1
+	}
+
+	if m := set.longestPrefixString(s[i:end]); m > 0 && m < end-i {
+		end = i + m
+	}
+	if end == j && j < len(s) && isDigitASCII(s[j]) && !str.splitNumberASCII(s[i:j], s[j]) {
+		// The number that follows is glued, like in extractNumberSuffix.
+		return str.asciiLowerEnd(s, i, j+1)
+	}
+
+	return end
+}
+
+// splitNumberASCII is like splitNumber for ASCII words.
+func (str *String) splitNumberASCII(word string, next byte) bool {
+	last := word[len(word)-1]
+	if isDigitASCII(last) == isDigitASCII(next) || !isLetterASCII(last) && !isLetterASCII(next) {
+		return false
+	}
+
+	switch str.numbers {
+	case NumberSplit:
+		return true
+	case NumberGlue:
+		return false
+	case NumberGlueInitialism:
+		return isDigitASCII(next) && !str.isInitialism(word)
+	default:
+		return isDigitASCII(next) && len(word) > 1 && isUpperWordASCII(word)
+	}
+}
+
+// appendASCIIToken is like caseToken for ASCII words, but appends the cased
+// word to dst.
+func (str *String) appendASCIIToken(dst []byte, i int, token string, f Format, romans bool) []byte {
+	wc := f.Rest
+	if i == 0 {
+		wc = f.First
+	}
+
+	start := len(dst)
+	dst = str.appendASCIIWord(dst, token, wc, f.Initialisms)
+	if romans && (f.Initialisms == AllInitialisms || f.Initialisms == TitleInitialisms && wc == WordTitle) && isRomanNumeral(token) {
+		dst = append(dst[:start], token...)
+	}
+	if i == 0 && wc == WordLower && f.Initialisms != NoInitialisms && str.leading == LeadingLowerFirst {
+		var buf [32]byte
+		if u := appendUpperASCII(buf[:0], token); str.initialisms.Load().words[string(u)] {
+			dst = append(appendLowerASCII(dst[:start], u[:1]), u[1:]...)
+		}
+	}
+
+	return dst
+}
+
+// appendASCIIWord is like caseWord for ASCII words, but appends the cased
+// word to dst.
+func (str *String) appendASCIIWord(dst []byte, token string, wc WordCase, p InitialismPolicy) []byte {
+	if !isASCIIWord(token) {
+		// Versions such as "v1.2" are cased by the casers of x/text.
+		return append(dst, str.caseWord(token, wc, p)...)
+	}
+	if str.ignoreInitialisms {
+		p = NoInitialisms
+	}
+
+	if p == AllInitialisms || p == TitleInitialisms && wc == WordTitle {
+		var lower, upper [32]byte
+		u := appendUpperASCII(upper[:0], token)
+
+		var w string
+		var ok bool
+		if len(str.specialWords) > 0 {
+			w, ok = str.specialWords[string(appendLowerASCII(lower[:0], token))]
+		}
+
+		set := str.initialisms.Load()
+		if !ok {
+			w, ok = set.mixed[string(u)]
+		}
+		if ok {
+			if wc == WordTitle {
+				r, n := utf8.DecodeRuneInString(w)
+				dst = utf8.AppendRune(dst, unicode.ToUpper(r))
+				w = w[n:]
+			}
+
+			return append(dst, w...)
+		}
+		if set.words[string(u)] {
+			return append(dst, u...)
+		}
+		if n := len(u) - 1; token[n] == 's' && wc != WordUpper && set.words[string(u[:n])] {
+			return append(append(dst, u[:n]...), 's')
+		}
+		if str.acronyms && len(token) > 1 && isUpperWordASCII(token) {
+			return append(dst, token...)
+		}
+	}
+
+	switch wc {
+	case WordUpper:
+		return appendUpperASCII(dst, token)
+	case WordTitle:
+		return appendTitleASCII(dst, token)
+	default:
+		return appendLowerASCII(dst, token)
+	}
+}
+
+func appendLowerASCII[T string | []byte](dst []byte, s T) []byte {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isUpperASCII(c) {
+			c += 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+
+	return dst
+}
+
+func appendUpperASCII(dst []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isLowerASCII(c) {
+			c -= 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+
+	return dst
+}
+
+// appendTitleASCII is like asciiTitle, but appends to dst.
+func appendTitleASCII(dst []byte, s string) []byte {
+	letter := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case !letter && isLetterASCII(c):
+			letter = true
+			if isLowerASCII(c) {
+				c -= 'a' - 'A'
+			}
+		case letter && isUpperASCII(c):
+			c += 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+
+	return dst
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isUpperWordASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isUpperASCII(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isLowerASCII(c byte) bool { return 'a' <= c && c <= 'z' }
+
+func isUpperASCII(c byte) bool { return 'A' <= c && c <= 'Z' }
+
+func isDigitASCII(c byte) bool { return '0' <= c && c <= '9' }
+
+func isLetterASCII(c byte) bool { return isLowerASCII(c) || isUpperASCII(c) }
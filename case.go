@@ -0,0 +1,231 @@
+package stringcases
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var (
+	Detect           = s.Detect
+	IsSnake          = s.IsSnake
+	IsKebab          = s.IsKebab
+	IsCamel          = s.IsCamel
+	IsPascal         = s.IsPascal
+	IsScreamingSnake = s.IsScreamingSnake
+	Convert          = s.Convert
+)
+
+// Case is a naming convention.
+type Case int
+
+const (
+	Unknown Case = iota
+	Snake
+	Kebab
+	Camel
+	Pascal
+	ScreamingSnake
+
+	// Mixed is a string that mixes conventions, e.g. "user_Name" or
+	// "user-name_id".
+	Mixed
+
+	Title
+	Sentence
+	Human
+	Header
+	NoCase
+)
+
+var caseNames = [...]string{
+	Unknown:        "unknown",
+	Snake:          "snake",
+	Kebab:          "kebab",
+	Camel:          "camel",
+	Pascal:         "pascal",
+	ScreamingSnake: "screaming-snake",
+	Mixed:          "mixed",
+	Title:          "title",
+	Sentence:       "sentence",
+	Human:          "human",
+	Header:         "header",
+	NoCase:         "no-case",
+}
+
+// String returns the name of the case, e.g. "screaming-snake", which is also
+// its name in the registry.
+func (c Case) String() string {
+	if c < 0 || int(c) >= len(caseNames) {
+		return fmt.Sprintf("Case(%d)", int(c))
+	}
+
+	return caseNames[c]
+}
+
+// ParseCase returns the case with the name, ignoring case, and treating "_"
+// and spaces like "-", so "screaming-snake", "SCREAMING_SNAKE" and
+// "Screaming Snake" all parse. Unknown and Mixed describe inputs rather than
+// conventions, and are rejected with ErrUnknownCase like other names.
+func ParseCase(name string) (Case, error) {
+	n := strings.NewReplacer("_", "-", " ", "-").Replace(strings.ToLower(strings.TrimSpace(name)))
+	for c, s := range caseNames {
+		if s == n && Case(c) != Unknown && Case(c) != Mixed {
+			return Case(c), nil
+		}
+	}
+
+	return Unknown, fmt.Errorf("%w: %q, want one of %s", ErrUnknownCase, name, strings.Join(validCases(), ", "))
+}
+
+// Convert converts the string into the case, e.g. Convert("userID", Kebab)
+// returns "user-id". It returns ErrUnknownCase for Unknown, Mixed and other
+// values that ParseCase does not return.
+func (str *String) Convert(s string, to Case) (string, error) {
+	switch to {
+	case Snake:
+		return str.ToSnake(s), nil
+	case Kebab:
+		return str.ToKebab(s), nil
+	case Camel:
+		return str.ToCamel(s), nil
+	case Pascal:
+		return str.ToPascal(s), nil
+	case ScreamingSnake:
+		return str.ToScreamingSnake(s), nil
+	case Title:
+		return str.ToTitle(s), nil
+	case Sentence:
+		return str.ToSentence(s), nil
+	case Human:
+		return str.ToHuman(s), nil
+	case Header:
+		return str.ToHeader(s), nil
+	case NoCase:
+		return str.ToNoCase(s), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownCase, to)
+	}
+}
+
+// Set parses the name into the case, so a Case can be used with flag.Var,
+// e.g. --case=screaming-snake.
+func (c *Case) Set(name string) error {
+	v, err := ParseCase(name)
+	if err != nil {
+		return err
+	}
+
+	*c = v
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Only the cases that
+// ParseCase accepts can be marshaled.
+func (c Case) MarshalText() ([]byte, error) {
+	if c == Unknown || c == Mixed || c < 0 || int(c) >= len(caseNames) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCase, c)
+	}
+
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *Case) UnmarshalText(b []byte) error {
+	return c.Set(string(b))
+}
+
+func validCases() []string {
+	var names []string
+	for c, s := range caseNames {
+		if Case(c) != Unknown && Case(c) != Mixed {
+			names = append(names, s)
+		}
+	}
+
+	return names
+}
+
+// Detect classifies the convention of the string, e.g. "user_id" is Snake
+// and "userID" is Camel. Single lowercase words, which are valid snake,
+// kebab and camel case, are reported as Snake, and single uppercase words as
+// ScreamingSnake. Strings with other characters, such as spaces, or without
+// letters are Unknown.
+func (str *String) Detect(s string) Case {
+	var upper, lower, underscore, hyphen bool
+	for _, r := range s {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsNumber(r):
+		case r == '_':
+			underscore = true
+		case r == '-':
+			hyphen = true
+		default:
+			return Unknown
+		}
+	}
+
+	switch {
+	case !upper && !lower:
+		return Unknown
+	case underscore && hyphen:
+		return Mixed
+	case underscore:
+		if upper && lower {
+			return Mixed
+		}
+		if upper {
+			return ScreamingSnake
+		}
+
+		return Snake
+	case hyphen:
+		if upper {
+			return Mixed
+		}
+
+		return Kebab
+	case !lower:
+		return ScreamingSnake
+	case !upper:
+		return Snake
+	case startsUpper(strings.TrimLeftFunc(s, unicode.IsNumber)):
+		return Pascal
+	default:
+		return Camel
+	}
+}
+
+// IsSnake reports whether the string is already in snake case, i.e. ToSnake
+// leaves it unchanged.
+func (str *String) IsSnake(s string) bool {
+	return s != "" && str.ToSnake(s) == s
+}
+
+// IsKebab reports whether the string is already in kebab case.
+func (str *String) IsKebab(s string) bool {
+	return s != "" && str.ToKebab(s) == s
+}
+
+// IsCamel reports whether the string is already in camel case, including
+// the casing of initialisms, so "userID" is, but "userId" is not.
+func (str *String) IsCamel(s string) bool {
+	return s != "" && str.ToCamel(s) == s
+}
+
+// IsPascal reports whether the string is already in Pascal case, including
+// the casing of initialisms.
+func (str *String) IsPascal(s string) bool {
+	return s != "" && str.ToPascal(s) == s
+}
+
+// IsScreamingSnake reports whether the string is already in screaming snake
+// case.
+func (str *String) IsScreamingSnake(s string) bool {
+	return s != "" && str.ToScreamingSnake(s) == s
+}
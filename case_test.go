@@ -0,0 +1,193 @@
+package stringcases_test
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		text string
+		want stringcases.Case
+	}{
+		{"user_id", stringcases.Snake},
+		{"__init__", stringcases.Snake},
+		{"user", stringcases.Snake},
+		{"user2", stringcases.Snake},
+		{"user-id", stringcases.Kebab},
+		{"userID", stringcases.Camel},
+		{"2faCode", stringcases.Camel},
+		{"UserID", stringcases.Pascal},
+		{"HTTPServer", stringcases.Pascal},
+		{"USER_ID", stringcases.ScreamingSnake},
+		{"HTTP", stringcases.ScreamingSnake},
+		{"user_Name", stringcases.Mixed},
+		{"User-Name", stringcases.Mixed},
+		{"user-name_id", stringcases.Mixed},
+		{"user name", stringcases.Unknown},
+		{"user.name", stringcases.Unknown},
+		{"123", stringcases.Unknown},
+		{"", stringcases.Unknown},
+	}
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.Detect(test.text))
+		})
+	}
+}
+
+func TestIs(t *testing.T) {
+	tests := []struct {
+		text                                        string
+		snake, kebab, camel, pascal, screamingSnake bool
+	}{
+		{"user_id", true, false, false, false, false},
+		{"user-id", false, true, false, false, false},
+		{"userID", false, false, true, false, false},
+		{"userId", false, false, false, false, false},
+		{"UserID", false, false, false, true, false},
+		{"UserId", false, false, false, false, false},
+		{"USER_ID", false, false, false, false, true},
+		{"user", true, true, true, false, false},
+		{"user__id", false, false, false, false, false},
+		{"", false, false, false, false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(test.snake, stringcases.IsSnake(test.text), "snake")
+			assert.Equal(test.kebab, stringcases.IsKebab(test.text), "kebab")
+			assert.Equal(test.camel, stringcases.IsCamel(test.text), "camel")
+			assert.Equal(test.pascal, stringcases.IsPascal(test.text), "pascal")
+			assert.Equal(test.screamingSnake, stringcases.IsScreamingSnake(test.text), "screaming snake")
+		})
+	}
+}
+
+func TestParseCase(t *testing.T) {
+	tests := []struct {
+		name string
+		want stringcases.Case
+	}{
+		{"snake", stringcases.Snake},
+		{"Kebab", stringcases.Kebab},
+		{"camel", stringcases.Camel},
+		{"PASCAL", stringcases.Pascal},
+		{"screaming-snake", stringcases.ScreamingSnake},
+		{"SCREAMING_SNAKE", stringcases.ScreamingSnake},
+		{"screaming snake", stringcases.ScreamingSnake},
+		{" title ", stringcases.Title},
+		{"sentence", stringcases.Sentence},
+		{"human", stringcases.Human},
+		{"header", stringcases.Header},
+		{"no-case", stringcases.NoCase},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := stringcases.ParseCase(test.name)
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		for _, name := range []string{"", "unknown", "mixed", "cobol"} {
+			_, err := stringcases.ParseCase(name)
+			assert.ErrorIs(t, err, stringcases.ErrUnknownCase, name)
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		assert := assert.New(t)
+		assert.Equal("screaming-snake", stringcases.ScreamingSnake.String())
+		assert.Equal("unknown", stringcases.Unknown.String())
+		assert.Equal("Case(42)", stringcases.Case(42).String())
+
+		for c := stringcases.Snake; c <= stringcases.NoCase; c++ {
+			if c == stringcases.Mixed {
+				continue
+			}
+
+			got, err := stringcases.ParseCase(c.String())
+			assert.NoError(err)
+			assert.Equal(c, got)
+		}
+	})
+}
+
+func TestCaseFlag(t *testing.T) {
+	t.Run("flag", func(t *testing.T) {
+		c := stringcases.Camel
+
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		fs.Var(&c, "case", "naming convention")
+
+		assert := assert.New(t)
+		assert.NoError(fs.Parse([]string{"--case=screaming-snake"}))
+		assert.Equal(stringcases.ScreamingSnake, c)
+
+		err := fs.Parse([]string{"--case=cobol"})
+		assert.ErrorContains(err, "want one of snake, kebab, camel")
+		assert.Equal(stringcases.ScreamingSnake, c)
+	})
+
+	t.Run("text", func(t *testing.T) {
+		type config struct {
+			Case stringcases.Case `json:"case"`
+		}
+
+		assert := assert.New(t)
+
+		b, err := json.Marshal(config{Case: stringcases.Kebab})
+		assert.NoError(err)
+		assert.Equal(`{"case":"kebab"}`, string(b))
+
+		var c config
+		assert.NoError(json.Unmarshal([]byte(`{"case":"PASCAL"}`), &c))
+		assert.Equal(stringcases.Pascal, c.Case)
+
+		assert.ErrorIs(json.Unmarshal([]byte(`{"case":"mixed"}`), &c), stringcases.ErrUnknownCase)
+
+		_, err = json.Marshal(config{Case: stringcases.Mixed})
+		assert.ErrorIs(err, stringcases.ErrUnknownCase)
+	})
+}
+
+func TestConvert(t *testing.T) {
+	for c := stringcases.Snake; c <= stringcases.NoCase; c++ {
+		if c == stringcases.Mixed {
+			continue
+		}
+
+		t.Run(c.String(), func(t *testing.T) {
+			want, ok := stringcases.Get(c.String())
+			assert.True(t, ok)
+
+			got, err := stringcases.Convert("userAPIKey", c)
+			assert.NoError(t, err)
+			assert.Equal(t, want("userAPIKey"), got)
+		})
+	}
+
+	t.Run("example", func(t *testing.T) {
+		got, err := stringcases.Convert("userID", stringcases.Kebab)
+		assert.NoError(t, err)
+		assert.Equal(t, "user-id", got)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		for _, c := range []stringcases.Case{stringcases.Unknown, stringcases.Mixed, stringcases.Case(42)} {
+			_, err := stringcases.Convert("userID", c)
+			assert.ErrorIs(t, err, stringcases.ErrUnknownCase)
+		}
+	})
+}
@@ -0,0 +1,221 @@
+package stringcases
+
+import (
+	"sort"
+	"strings"
+)
+
+var (
+	Equal      = s.Equal
+	Compare    = s.Compare
+	Normalize  = s.Normalize
+	Similarity = s.Similarity
+)
+
+// Normalize returns the canonical form of the string, its lowercase words
+// joined by "_", e.g. "userID", "User-Id" and "USER_ID" all normalize to
+// "user_id". Normalize agrees with Equal, so it can be used as a map key to
+// dedupe different spellings of a name:
+//
+//	fields := make(map[string]string)
+//	fields[stringcases.Normalize("userID")] = "UserID"
+//	_, ok := fields[stringcases.Normalize("user_id")] // true
+//
+// Every converter splits words with the same tokenizer, see Tokenize, so
+// strings with the same canonical form convert to the same output, and
+// converting a string usually keeps its canonical form. Uppercase words
+// followed by digits are an exception, since "KEY2" splits into "KEY" and
+// "2" while "Key2" does not. Unlike ToSnake, Normalize ignores WithMaxLength
+// and WithHashSuffix, and drops leading and trailing underscores.
+func (str *String) Normalize(s string) string {
+	return strings.Join(str.words(s), "_")
+}
+
+// Equal reports whether the strings have the same words, ignoring their
+// convention and casing, e.g. "userID", "user_id" and "User-Id" are equal.
+// The words are lowercased with the language of the instance.
+func (str *String) Equal(a, b string) bool {
+	x, y := str.words(a), str.words(b)
+	if len(x) != len(y) {
+		return false
+	}
+
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Compare compares the strings word by word, like Equal, and returns -1, 0 or
+// +1. Runs of digits are compared by their value, so "item2" sorts before
+// "item10", and a string sorts before the strings that it is a prefix of.
+// Compare returns 0 exactly when Equal returns true, e.g.
+//
+//	sort.Slice(names, func(i, j int) bool {
+//		return stringcases.Compare(names[i], names[j]) < 0
+//	})
+func (str *String) Compare(a, b string) int {
+	x, y := str.words(a), str.words(b)
+	for i := range min(len(x), len(y)) {
+		if c := compareNatural(x[i], y[i]); c != 0 {
+			return c
+		}
+	}
+
+	return compareInt(len(x), len(y))
+}
+
+// Similarity scores how alike the words of the strings are, from 0 to 1,
+// ignoring their convention and casing like Equal, e.g. "userID" and
+// "user_id" score 1, and "usrId" scores 0.875 against both. Words are paired
+// up regardless of their order, and similar words such as "usr" and "user"
+// count in part, by their edit distance. Words that share less than half of
+// their letters do not count.
+func (str *String) Similarity(a, b string) float64 {
+	x, y := str.words(a), str.words(b)
+	if len(x) == 0 && len(y) == 0 {
+		return 1
+	}
+
+	type pair struct {
+		i, j  int
+		score float64
+	}
+	var pairs []pair
+	for i, v := range x {
+		for j, w := range y {
+			if score := wordSimilarity(v, w); score >= 0.5 {
+				pairs = append(pairs, pair{i, j, score})
+			}
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].score > pairs[j].score
+	})
+
+	var total float64
+	usedX, usedY := make([]bool, len(x)), make([]bool, len(y))
+	for _, p := range pairs {
+		if !usedX[p.i] && !usedY[p.j] {
+			usedX[p.i], usedY[p.j] = true, true
+			total += p.score
+		}
+	}
+
+	return 2 * total / float64(len(x)+len(y))
+}
+
+// wordSimilarity scores the words by their Levenshtein distance, relative
+// to the length of the longer word.
+func wordSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+
+	r, s := []rune(a), []rune(b)
+	prev, cur := make([]int, len(s)+1), make([]int, len(s)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(r); i++ {
+		cur[0] = i
+		for j := 1; j <= len(s); j++ {
+			cost := 1
+			if r[i-1] == s[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return 1 - float64(prev[len(s)])/float64(max(len(r), len(s)))
+}
+
+// compareNatural compares the strings in runs of digits and non-digits.
+// Runs of digits are compared by value, ignoring leading zeros, and other
+// runs bytewise. Equal numbers with more leading zeros sort last.
+func compareNatural(a, b string) int {
+	tie := 0
+	for a != "" && b != "" {
+		x, restA := cutRun(a)
+		y, restB := cutRun(b)
+		a, b = restA, restB
+
+		if isDigit(x[0]) && isDigit(y[0]) {
+			if tie == 0 && len(x) != len(y) {
+				tie = compareInt(len(x), len(y))
+			}
+			x, y = strings.TrimLeft(x, "0"), strings.TrimLeft(y, "0")
+			if len(x) != len(y) {
+				return compareInt(len(x), len(y))
+			}
+		}
+
+		if c := strings.Compare(x, y); c != 0 {
+			return c
+		}
+	}
+
+	if c := strings.Compare(a, b); c != 0 {
+		return c
+	}
+
+	return tie
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// cutRun cuts the leading run of ASCII digits or non-digits off s.
+func cutRun(s string) (run, rest string) {
+	digit := isDigit(s[0])
+
+	i := 1
+	for i < len(s) && isDigit(s[i]) == digit {
+		i++
+	}
+
+	return s[:i], s[i:]
+}
+
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+// words returns the lowercase words of s, without the empty words of
+// WithDelimiterRuns.
+func (str *String) words(s string) []string {
+	return str.lowerAll(str.nonEmptyWords(s))
+}
+
+func (str *String) nonEmptyWords(s string) []string {
+	var words []string
+	for w := range str.Words(s) {
+		if w != "" {
+			words = append(words, w)
+		}
+	}
+
+	return words
+}
+
+func (str *String) lowerAll(words []string) []string {
+	lower := make([]string, len(words))
+	for i, w := range words {
+		lower[i] = str.lower(w)
+	}
+
+	return lower
+}
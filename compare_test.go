@@ -0,0 +1,151 @@
+package stringcases_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"userID", "user_id", true},
+		{"userID", "User-Id", true},
+		{"UserAPIKey", "user api key", true},
+		{"USER_NAME", "userName", true},
+		{"", "", true},
+		{"__user__id", "userId", true},
+		{"userID", "userIDs", false},
+		{"username", "user_name", false},
+		{"user", "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" "+test.b, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.Equal(test.a, test.b))
+		})
+	}
+
+	t.Run("delimiter runs", func(t *testing.T) {
+		str := stringcases.New(language.Und, stringcases.WithDelimiterRuns())
+		assert.True(t, str.Equal("user__id", "userID"))
+	})
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"item2", "item10", -1},
+		{"item10", "item2", 1},
+		{"item_2", "Item_10", -1},
+		{"userID", "user_id", 0},
+		{"user", "userID", -1},
+		{"userName", "user", 1},
+		{"abc", "abd", -1},
+		{"v007", "v7", 1},
+		{"v7", "v007", -1},
+		{"v007a", "v7b", -1},
+		{"page2Size", "page2Count", 1},
+		{"", "a", -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" "+test.b, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.Compare(test.a, test.b))
+		})
+	}
+
+	t.Run("sort", func(t *testing.T) {
+		names := []string{"item10", "Item1", "item2", "item", "itemB", "itemA"}
+		sort.Slice(names, func(i, j int) bool {
+			return stringcases.Compare(names[i], names[j]) < 0
+		})
+		assert.Equal(t, []string{"item", "itemA", "itemB", "Item1", "item2", "item10"}, names)
+	})
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"", ""},
+		{"userID", "user_id"},
+		{"User-Id", "user_id"},
+		{"USER_ID", "user_id"},
+		{"__user_id__", "user_id"},
+		{"HTTPServer", "http_server"},
+		{"user api key", "user_api_key"},
+		{"KEY2", "key_2"},
+		{"Key2", "key2"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.Normalize(test.text))
+		})
+	}
+
+	t.Run("converters", func(t *testing.T) {
+		assert := assert.New(t)
+
+		text := "userAPIKeyFast"
+		want := stringcases.Normalize(text)
+		for _, conv := range []func(string) string{
+			stringcases.ToSnake,
+			stringcases.ToKebab,
+			stringcases.ToCamel,
+			stringcases.ToPascal,
+			stringcases.ToScreamingSnake,
+			stringcases.ToTitle,
+			stringcases.ToHeader,
+		} {
+			got := conv(text)
+			assert.Equal(want, stringcases.Normalize(got), got)
+			assert.True(stringcases.Equal(text, got), got)
+		}
+	})
+
+	t.Run("max length", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithMaxLength(4, stringcases.TruncateWords))
+		assert.Equal(t, "user_name", str.Normalize("userName"))
+	})
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"userID", "user_id", 1},
+		{"", "", 1},
+		{"userID", "", 0},
+		{"userID", "usrId", 0.875},
+		{"user_id", "usrId", 0.875},
+		{"userName", "nameUser", 1},
+		{"userName", "user", 2.0 / 3},
+		{"apple", "orange", 0},
+		{"orderID", "userID", 0.5},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" "+test.b, func(t *testing.T) {
+			assert.InDelta(t, test.want, stringcases.Similarity(test.a, test.b), 1e-9)
+		})
+	}
+
+	t.Run("rank", func(t *testing.T) {
+		candidates := []string{"orderID", "usrId", "userName", "user_id", "accountID"}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return stringcases.Similarity("userID", candidates[i]) > stringcases.Similarity("userID", candidates[j])
+		})
+		assert.Equal(t, []string{"user_id", "usrId", "orderID", "userName", "accountID"}, candidates)
+	})
+}
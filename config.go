@@ -0,0 +1,450 @@
+package stringcases
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Config describes the settings of an instance, and can be marshaled as JSON
+// to share them between machines, e.g.
+//
+//	b, _ := json.Marshal(str.Config())
+//	...
+//	var c stringcases.Config
+//	_ = json.Unmarshal(b, &c)
+//	str, err := stringcases.NewFromConfig(c)
+//
+// Functions, such as those of WithDisallowedRunes, WithSeparators,
+// WithTokenHook and WithTokenizer, cannot be described and are left out.
+type Config struct {
+	Language  language.Tag `json:"language"`
+	ASCIICase bool         `json:"asciiCase,omitempty"`
+
+	// Initialisms and MinorWords list every initialism and minor word, and
+	// replace the defaults unless they are null.
+	Initialisms       []string              `json:"initialisms"`
+	IgnoreInitialisms bool                  `json:"ignoreInitialisms,omitempty"`
+	UppercaseAcronyms bool                  `json:"uppercaseAcronyms,omitempty"`
+	AcronymBoundary   AcronymBoundaryPolicy `json:"acronymBoundary,omitempty"`
+	SpecialWords      []string              `json:"specialWords,omitempty"`
+	MinorWords        []string              `json:"minorWords"`
+
+	Numbers         NumberPolicy `json:"numbers,omitempty"`
+	Versions        bool         `json:"versions,omitempty"`
+	NumericPatterns bool         `json:"numericPatterns,omitempty"`
+	RomanNumerals   bool         `json:"romanNumerals,omitempty"`
+	Graphemes       bool         `json:"graphemeClusters,omitempty"`
+	Delimiters      bool         `json:"delimiters,omitempty"`
+	Underscores     bool         `json:"underscores,omitempty"`
+	Symbols         SymbolPolicy `json:"symbols,omitempty"`
+	Placeholder     string       `json:"placeholder,omitempty"`
+
+	// Normalization is one of "NFC", "NFD", "NFKC" and "NFKD", or empty.
+	Normalization   string `json:"normalization,omitempty"`
+	StripDiacritics bool   `json:"stripDiacritics,omitempty"`
+	Transliterate   bool   `json:"transliterate,omitempty"`
+
+	// Transliteration lists the entries added to the default table of
+	// WithTransliteration.
+	Transliteration map[string]string `json:"transliteration,omitempty"`
+
+	CJK         CJKPolicy        `json:"cjk,omitempty"`
+	Emoji       EmojiPolicy      `json:"emoji,omitempty"`
+	SharpS      SharpSPolicy     `json:"sharpS,omitempty"`
+	InvalidUTF8 UTF8Policy       `json:"invalidUTF8,omitempty"`
+	Whitespace  WhitespacePolicy `json:"whitespace,omitempty"`
+
+	// ContractionTable lists the entries added to the default table of
+	// WithContractions.
+	Contractions     ContractionPolicy `json:"contractions,omitempty"`
+	ContractionTable map[string]string `json:"contractionTable,omitempty"`
+
+	Hungarian     []string          `json:"hungarian,omitempty"`
+	TrimPrefixes  []string          `json:"trimPrefixes,omitempty"`
+	TrimSuffixes  []string          `json:"trimSuffixes,omitempty"`
+	Abbreviations map[string]string `json:"abbreviations,omitempty"`
+	Expansions    map[string]string `json:"expansions,omitempty"`
+
+	MaxLength      int            `json:"maxLength,omitempty"`
+	LengthStrategy LengthStrategy `json:"lengthStrategy,omitempty"`
+	HashLength     int            `json:"hashLength,omitempty"`
+	MaxWords       int            `json:"maxWords,omitempty"`
+
+	LeadingInitialism LeadingInitialismPolicy `json:"leadingInitialism,omitempty"`
+	MinimalChanges    bool                    `json:"minimalChanges,omitempty"`
+	Ambiguity         AmbiguityPolicy         `json:"ambiguity,omitempty"`
+	AmbiguityMarker   string                  `json:"ambiguityMarker,omitempty"`
+}
+
+var normForms = map[string]norm.Form{
+	"NFC":  norm.NFC,
+	"NFD":  norm.NFD,
+	"NFKC": norm.NFKC,
+	"NFKD": norm.NFKD,
+}
+
+// Config returns the settings of the instance.
+func (str *String) Config() Config {
+	c := Config{
+		Language:          str.tag,
+		ASCIICase:         str.asciiCase,
+		Initialisms:       str.Initialisms(),
+		IgnoreInitialisms: str.ignoreInitialisms,
+		UppercaseAcronyms: str.acronyms,
+		AcronymBoundary:   str.acronymBoundary,
+		MinorWords:        []string{},
+		Numbers:           str.numbers,
+		Versions:          str.versions,
+		NumericPatterns:   str.patterns,
+		RomanNumerals:     str.romans,
+		Graphemes:         str.graphemes,
+		Delimiters:        str.delimiters,
+		Underscores:       str.underscores,
+		Symbols:           str.symbols,
+		Placeholder:       str.placeholder,
+		StripDiacritics:   str.diacritics,
+		Transliterate:     str.transliteration != nil,
+		CJK:               str.cjk,
+		Emoji:             str.emoji,
+		SharpS:            str.sharpS,
+		InvalidUTF8:       str.invalidUTF8,
+		Whitespace:        str.whitespace,
+		Contractions:      str.contractions,
+		Hungarian:         append([]string(nil), str.hungarian...),
+		TrimPrefixes:      append([]string(nil), str.trimPrefixes...),
+		TrimSuffixes:      append([]string(nil), str.trimSuffixes...),
+		Abbreviations:     copyMap(str.abbreviations),
+		Expansions:        copyMap(str.expansions),
+		MaxLength:         str.maxLength,
+		MaxWords:          str.maxWords,
+		LengthStrategy:    str.lengthStrategy,
+		HashLength:        str.hashLength,
+		LeadingInitialism: str.leading,
+		MinimalChanges:    str.minimal,
+		Ambiguity:         str.ambiguity,
+		AmbiguityMarker:   str.marker,
+	}
+
+	for _, w := range str.specialWords {
+		c.SpecialWords = append(c.SpecialWords, w)
+	}
+	sort.Strings(c.SpecialWords)
+
+	for w, ok := range str.minorWords {
+		if ok {
+			c.MinorWords = append(c.MinorWords, w)
+		}
+	}
+	sort.Strings(c.MinorWords)
+
+	if str.normalize {
+		for name, f := range normForms {
+			if f == str.form {
+				c.Normalization = name
+			}
+		}
+	}
+
+	for r, s := range str.transliteration {
+		if asciiFold[r] != s {
+			if c.Transliteration == nil {
+				c.Transliteration = make(map[string]string)
+			}
+			c.Transliteration[string(r)] = s
+		}
+	}
+
+	for k, v := range str.contractionTable {
+		if defaultContractions[k] != v {
+			if c.ContractionTable == nil {
+				c.ContractionTable = make(map[string]string)
+			}
+			c.ContractionTable[k] = v
+		}
+	}
+
+	return c
+}
+
+// NewFromConfig returns an instance with the settings of c. Unlike New, it
+// returns an error when the settings are invalid, see Validate.
+func NewFromConfig(c Config) (*String, error) {
+	opts := []Option{
+		WithNumberPolicy(c.Numbers),
+		WithSymbolPolicy(c.Symbols),
+		WithCJKPolicy(c.CJK),
+		WithEmojiPolicy(c.Emoji),
+		WithSharpSPolicy(c.SharpS),
+		WithUTF8Policy(c.InvalidUTF8),
+		WithAcronymBoundary(c.AcronymBoundary),
+		WithWhitespacePolicy(c.Whitespace),
+		WithLeadingInitialism(c.LeadingInitialism),
+		WithAmbiguityPolicy(c.Ambiguity),
+		WithMaxLength(c.MaxLength, c.LengthStrategy),
+		WithMaxWords(c.MaxWords),
+		WithHashSuffix(c.HashLength),
+		WithTrimPrefixes(c.TrimPrefixes...),
+		WithTrimSuffixes(c.TrimSuffixes...),
+		func(str *String) {
+			str.placeholder = c.Placeholder
+			str.marker = c.AmbiguityMarker
+		},
+	}
+	if c.Initialisms != nil {
+		initialisms := make(map[string]bool, len(c.Initialisms))
+		for _, w := range c.Initialisms {
+			initialisms[w] = true
+		}
+		opts = append(opts, WithInitialisms(initialisms))
+	}
+	if c.MinorWords != nil {
+		opts = append(opts, WithMinorWords(c.MinorWords...))
+	}
+	if c.ASCIICase {
+		opts = append(opts, WithASCIICase())
+	}
+	if c.IgnoreInitialisms {
+		opts = append(opts, WithoutInitialisms())
+	}
+	if c.UppercaseAcronyms {
+		opts = append(opts, WithUppercaseAcronyms())
+	}
+	if c.MinimalChanges {
+		opts = append(opts, WithMinimalChanges())
+	}
+	if len(c.SpecialWords) > 0 {
+		opts = append(opts, WithSpecialWords(c.SpecialWords...))
+	}
+	if c.Versions {
+		opts = append(opts, WithVersionTokens())
+	}
+	if c.NumericPatterns {
+		opts = append(opts, WithNumericPatterns())
+	}
+	if c.RomanNumerals {
+		opts = append(opts, WithRomanNumerals())
+	}
+	if c.Graphemes {
+		opts = append(opts, WithGraphemeClusters())
+	}
+	if c.Delimiters {
+		opts = append(opts, WithDelimiterRuns())
+	}
+	if c.Underscores {
+		opts = append(opts, WithUnderscores())
+	}
+	if c.Normalization != "" {
+		f, ok := normForms[c.Normalization]
+		if !ok {
+			return nil, fmt.Errorf("%w: normalization %q", ErrInvalidOption, c.Normalization)
+		}
+		opts = append(opts, WithNormalization(f))
+	}
+	if c.StripDiacritics {
+		opts = append(opts, WithStripDiacritics())
+	}
+	if c.Transliterate {
+		table := make(map[rune]string, len(c.Transliteration))
+		for k, v := range c.Transliteration {
+			r, n := utf8.DecodeRuneInString(k)
+			if n == 0 || n != len(k) {
+				return nil, fmt.Errorf("%w: transliteration key %q is not a single rune", ErrInvalidOption, k)
+			}
+			table[r] = v
+		}
+		opts = append(opts, WithTransliteration(table))
+	}
+	if c.Contractions != ContractionIgnore || len(c.ContractionTable) > 0 {
+		opts = append(opts, WithContractions(c.Contractions, c.ContractionTable))
+	}
+	if len(c.Hungarian) > 0 {
+		opts = append(opts, WithStripHungarian(c.Hungarian...))
+	}
+	if len(c.Abbreviations) > 0 {
+		opts = append(opts, WithAbbreviations(c.Abbreviations))
+	}
+	if len(c.Expansions) > 0 {
+		opts = append(opts, WithExpansions(c.Expansions))
+	}
+
+	str := newString(c.Language, opts...)
+	if err := str.Validate(); err != nil {
+		return nil, err
+	}
+
+	return str, nil
+}
+
+func copyMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+
+	c := make(map[string]string, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+
+	return c
+}
+
+// policyNames are the names the policies are marshaled as in a Config, in
+// the order of their values, e.g. NumberGlue is "glue".
+var (
+	acronymBoundaryNames = []string{"greedy", "last-upper"}
+	numberNames          = []string{"default", "split", "glue", "glue-initialism", "units"}
+	symbolNames          = []string{"drop", "replace", "keep", "error"}
+	cjkNames             = []string{"drop", "passthrough", "segment"}
+	emojiNames           = []string{"symbol", "token", "separator"}
+	sharpSNames          = []string{"expand", "capital"}
+	utf8Names            = []string{"error", "replace", "drop"}
+	whitespaceNames      = []string{"separate", "ignore"}
+	contractionNames     = []string{"ignore", "apostrophe", "expand"}
+	lengthStrategyNames  = []string{"truncate", "drop-middle", "abbreviate"}
+	leadingNames         = []string{"lower", "lower-first", "error"}
+	ambiguityNames       = []string{"ignore", "mark", "error"}
+)
+
+func marshalPolicy[P ~int](p P, names []string, kind string) ([]byte, error) {
+	if p < 0 || int(p) >= len(names) {
+		return nil, fmt.Errorf("%w: %s %d", ErrInvalidOption, kind, p)
+	}
+
+	return []byte(names[p]), nil
+}
+
+func unmarshalPolicy[P ~int](p *P, b []byte, names []string, kind string) error {
+	for i, name := range names {
+		if name == string(b) {
+			*p = P(i)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s %q, want one of %s", ErrInvalidOption, kind, b, strings.Join(names, ", "))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p AcronymBoundaryPolicy) MarshalText() ([]byte, error) {
+	return marshalPolicy(p, acronymBoundaryNames, "acronym boundary policy")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *AcronymBoundaryPolicy) UnmarshalText(b []byte) error {
+	return unmarshalPolicy(p, b, acronymBoundaryNames, "acronym boundary policy")
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p NumberPolicy) MarshalText() ([]byte, error) {
+	return marshalPolicy(p, numberNames, "number policy")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *NumberPolicy) UnmarshalText(b []byte) error {
+	return unmarshalPolicy(p, b, numberNames, "number policy")
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p SymbolPolicy) MarshalText() ([]byte, error) {
+	return marshalPolicy(p, symbolNames, "symbol policy")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *SymbolPolicy) UnmarshalText(b []byte) error {
+	return unmarshalPolicy(p, b, symbolNames, "symbol policy")
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p CJKPolicy) MarshalText() ([]byte, error) {
+	return marshalPolicy(p, cjkNames, "CJK policy")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *CJKPolicy) UnmarshalText(b []byte) error {
+	return unmarshalPolicy(p, b, cjkNames, "CJK policy")
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p EmojiPolicy) MarshalText() ([]byte, error) {
+	return marshalPolicy(p, emojiNames, "emoji policy")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *EmojiPolicy) UnmarshalText(b []byte) error {
+	return unmarshalPolicy(p, b, emojiNames, "emoji policy")
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p SharpSPolicy) MarshalText() ([]byte, error) {
+	return marshalPolicy(p, sharpSNames, "sharp s policy")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *SharpSPolicy) UnmarshalText(b []byte) error {
+	return unmarshalPolicy(p, b, sharpSNames, "sharp s policy")
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p UTF8Policy) MarshalText() ([]byte, error) {
+	return marshalPolicy(p, utf8Names, "UTF-8 policy")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *UTF8Policy) UnmarshalText(b []byte) error {
+	return unmarshalPolicy(p, b, utf8Names, "UTF-8 policy")
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p WhitespacePolicy) MarshalText() ([]byte, error) {
+	return marshalPolicy(p, whitespaceNames, "whitespace policy")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *WhitespacePolicy) UnmarshalText(b []byte) error {
+	return unmarshalPolicy(p, b, whitespaceNames, "whitespace policy")
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p ContractionPolicy) MarshalText() ([]byte, error) {
+	return marshalPolicy(p, contractionNames, "contraction policy")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *ContractionPolicy) UnmarshalText(b []byte) error {
+	return unmarshalPolicy(p, b, contractionNames, "contraction policy")
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (l LengthStrategy) MarshalText() ([]byte, error) {
+	return marshalPolicy(l, lengthStrategyNames, "length strategy")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (l *LengthStrategy) UnmarshalText(b []byte) error {
+	return unmarshalPolicy(l, b, lengthStrategyNames, "length strategy")
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p LeadingInitialismPolicy) MarshalText() ([]byte, error) {
+	return marshalPolicy(p, leadingNames, "leading initialism policy")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *LeadingInitialismPolicy) UnmarshalText(b []byte) error {
+	return unmarshalPolicy(p, b, leadingNames, "leading initialism policy")
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p AmbiguityPolicy) MarshalText() ([]byte, error) {
+	return marshalPolicy(p, ambiguityNames, "ambiguity policy")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *AmbiguityPolicy) UnmarshalText(b []byte) error {
+	return unmarshalPolicy(p, b, ambiguityNames, "ambiguity policy")
+}
@@ -0,0 +1,117 @@
+package stringcases_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestConfig(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.German,
+			stringcases.WithInitialisms(map[string]bool{"ID": true, "IPv6": true}),
+			stringcases.WithSpecialWords("OAuth"),
+			stringcases.WithNumberPolicy(stringcases.NumberSplit),
+			stringcases.WithNormalization(norm.NFC),
+			stringcases.WithTransliteration(map[rune]string{'ü': "ue"}),
+			stringcases.WithSharpSPolicy(stringcases.SharpSCapital),
+			stringcases.WithTrimPrefixes("tbl_"),
+			stringcases.WithAbbreviations(map[string]string{"number": "num"}),
+			stringcases.WithMaxLength(32, stringcases.DropMiddleWords),
+			stringcases.WithMaxWords(3),
+			stringcases.WithMinimalChanges(),
+			stringcases.WithNumericPatterns(),
+			stringcases.WithRomanNumerals(),
+			stringcases.WithGraphemeClusters(),
+			stringcases.WithContractions(stringcases.ContractionExpand, map[string]string{"ain't": "is not"}),
+		)
+
+		b, err := json.Marshal(str.Config())
+		assert.NoError(err)
+
+		var c stringcases.Config
+		assert.NoError(json.Unmarshal(b, &c))
+		assert.Equal(str.Config(), c)
+
+		got, err := stringcases.NewFromConfig(c)
+		assert.NoError(err)
+		assert.Equal(str.Config(), got.Config())
+
+		for _, s := range []string{"tbl_userIPv6Number", "OAuthClient2", "Übergröße", "userId"} {
+			assert.Equal(str.ToPascal(s), got.ToPascal(s), s)
+			assert.Equal(str.ToHuman(s), got.ToHuman(s), s)
+			assert.Equal(str.ToScreamingSnake(s), got.ToScreamingSnake(s), s)
+		}
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str, err := stringcases.NewFromConfig(stringcases.Config{Language: language.English})
+		assert.NoError(err)
+		assert.Equal(stringcases.New(language.English).Config(), str.Config())
+		assert.Equal("UserID", str.ToPascal("user_id"))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		assert := assert.New(t)
+
+		var c stringcases.Config
+		assert.NoError(json.Unmarshal([]byte(`{"language": "en", "initialisms": ["SKU"], "trimSuffixes": ["_id"]}`), &c))
+
+		str, err := stringcases.NewFromConfig(c)
+		assert.NoError(err)
+		assert.Equal("productSKU", str.ToCamel("product_sku_id"))
+		assert.Equal("UserId", str.ToPascal("user_id_id"))
+	})
+
+	t.Run("policy names", func(t *testing.T) {
+		assert := assert.New(t)
+
+		in := `{"language":"en","initialisms":null,"acronymBoundary":"last-upper","minorWords":null,` +
+			`"numbers":"glue","symbols":"replace","cjk":"segment","emoji":"token","sharpS":"capital",` +
+			`"invalidUTF8":"drop","whitespace":"ignore","contractions":"expand","lengthStrategy":"abbreviate",` +
+			`"leadingInitialism":"error","ambiguity":"mark"}`
+
+		var c stringcases.Config
+		assert.NoError(json.Unmarshal([]byte(in), &c))
+		assert.Equal(stringcases.AcronymBoundaryLastUpper, c.AcronymBoundary)
+		assert.Equal(stringcases.NumberGlue, c.Numbers)
+		assert.Equal(stringcases.SymbolReplace, c.Symbols)
+		assert.Equal(stringcases.CJKSegment, c.CJK)
+		assert.Equal(stringcases.EmojiToken, c.Emoji)
+		assert.Equal(stringcases.SharpSCapital, c.SharpS)
+		assert.Equal(stringcases.UTF8Drop, c.InvalidUTF8)
+		assert.Equal(stringcases.WhitespaceIgnore, c.Whitespace)
+		assert.Equal(stringcases.ContractionExpand, c.Contractions)
+		assert.Equal(stringcases.AbbreviateWords, c.LengthStrategy)
+		assert.Equal(stringcases.LeadingError, c.LeadingInitialism)
+		assert.Equal(stringcases.AmbiguityMark, c.Ambiguity)
+
+		b, err := json.Marshal(c)
+		assert.NoError(err)
+		assert.JSONEq(in, string(b))
+
+		err = json.Unmarshal([]byte(`{"numbers":"gluey"}`), &c)
+		assert.ErrorIs(err, stringcases.ErrInvalidOption)
+
+		_, err = json.Marshal(stringcases.Config{Numbers: 42})
+		assert.ErrorIs(err, stringcases.ErrInvalidOption)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		assert := assert.New(t)
+
+		_, err := stringcases.NewFromConfig(stringcases.Config{Language: language.English, Normalization: "NFX"})
+		assert.ErrorIs(err, stringcases.ErrInvalidOption)
+
+		_, err = stringcases.NewFromConfig(stringcases.Config{Language: language.English, HashLength: 6})
+		assert.ErrorIs(err, stringcases.ErrConflictingOptions)
+	})
+}
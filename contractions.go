@@ -0,0 +1,109 @@
+package stringcases
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// defaultContractions maps contractions to their expanded form. Contractions
+// that are also common words without their apostrophe, such as "we'll"
+// (well), "it's" (its) and "I'd" (id), are left out.
+var defaultContractions = map[string]string{
+	"aren't":    "are not",
+	"can't":     "cannot",
+	"couldn't":  "could not",
+	"didn't":    "did not",
+	"doesn't":   "does not",
+	"don't":     "do not",
+	"hadn't":    "had not",
+	"hasn't":    "has not",
+	"haven't":   "have not",
+	"isn't":     "is not",
+	"mustn't":   "must not",
+	"needn't":   "need not",
+	"shouldn't": "should not",
+	"wasn't":    "was not",
+	"weren't":   "were not",
+	"won't":     "will not",
+	"wouldn't":  "would not",
+	"I'm":       "I am",
+	"I've":      "I have",
+	"you're":    "you are",
+	"you've":    "you have",
+	"you'll":    "you will",
+	"they're":   "they are",
+	"they've":   "they have",
+	"they'll":   "they will",
+	"we've":     "we have",
+	"that's":    "that is",
+	"there's":   "there is",
+	"what's":    "what is",
+}
+
+// ContractionPolicy controls how ToHuman spells contractions that lost their
+// apostrophe in an identifier, such as "dont" in "dont_retry".
+type ContractionPolicy int
+
+const (
+	// ContractionIgnore keeps the words as they are, e.g. "Dont retry".
+	ContractionIgnore ContractionPolicy = iota
+
+	// ContractionApostrophe restores the apostrophe, e.g. "Don't retry".
+	ContractionApostrophe
+
+	// ContractionExpand expands the contraction, e.g. "Do not retry".
+	ContractionExpand
+)
+
+// WithContractions sets how ToHuman spells contractions. The table maps
+// contractions, such as "don't", to their expanded form, such as "do not",
+// and extends a default table of common English contractions. Apostrophes
+// are removed from the input before it is tokenized, so "don't retry" is
+// recognized too.
+func WithContractions(p ContractionPolicy, table map[string]string) Option {
+	return func(str *String) {
+		str.contractions = p
+		str.contractionTable = make(map[string]string, len(defaultContractions)+len(table))
+		str.contractionIndex = make(map[string]string, len(defaultContractions)+len(table))
+		for _, m := range []map[string]string{defaultContractions, table} {
+			for k, v := range m {
+				str.contractionTable[k] = v
+				str.contractionIndex[strings.ToLower(removeApostrophes(k))] = k
+			}
+		}
+	}
+}
+
+// contract respells the contractions among the space separated words of s.
+// A replacement starts uppercase when the word it replaces does.
+func (str *String) contract(s string) string {
+	if str.contractions == ContractionIgnore {
+		return s
+	}
+
+	words := strings.Split(s, " ")
+	for i, w := range words {
+		c, ok := str.contractionIndex[strings.ToLower(w)]
+		if !ok {
+			continue
+		}
+
+		if str.contractions == ContractionExpand {
+			c = str.contractionTable[c]
+		}
+		if startsUpper(w) {
+			r, n := utf8.DecodeRuneInString(c)
+			c = string(unicode.ToUpper(r)) + c[n:]
+		}
+		words[i] = c
+	}
+
+	return strings.Join(words, " ")
+}
+
+var apostrophes = strings.NewReplacer("'", "", "’", "")
+
+func removeApostrophes(s string) string {
+	return apostrophes.Replace(s)
+}
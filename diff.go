@@ -0,0 +1,104 @@
+package stringcases
+
+var Diff = s.Diff
+
+// DiffOp is the kind of a Change.
+type DiffOp int
+
+const (
+	// DiffKeep is a word in both strings, possibly in a different case.
+	DiffKeep DiffOp = iota
+	DiffAdd
+	DiffRemove
+
+	// DiffChange is a word of a that was replaced by a word of b.
+	DiffChange
+)
+
+func (op DiffOp) String() string {
+	switch op {
+	case DiffKeep:
+		return "keep"
+	case DiffAdd:
+		return "add"
+	case DiffRemove:
+		return "remove"
+	case DiffChange:
+		return "change"
+	default:
+		return "unknown"
+	}
+}
+
+// Change is a step that turns the words of one string into the words of
+// another. Old is empty for DiffAdd, and New is empty for DiffRemove.
+type Change struct {
+	Op       DiffOp
+	Old, New string
+}
+
+// Diff returns the changes that turn the words of a into the words of b,
+// ignoring their convention and casing like Equal, e.g. "userID" and
+// "user_name_id" differ by the added word "name":
+//
+//	fmt.Println(stringcases.Diff("userID", "user_name_id"))
+//	// [{keep user user} {add  name} {keep ID id}]
+//
+// The words in between kept words are paired up as changes, and the rest
+// are added or removed. Diff returns nil when the strings have no words.
+func (str *String) Diff(a, b string) []Change {
+	x, y := str.nonEmptyWords(a), str.nonEmptyWords(b)
+	xl, yl := str.lowerAll(x), str.lowerAll(y)
+
+	// lcs[i][j] is the length of the longest common subsequence of xl[i:]
+	// and yl[j:].
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if xl[i] == yl[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var changes []Change
+	var removed, added []string
+	flush := func() {
+		n := min(len(removed), len(added))
+		for k := range n {
+			changes = append(changes, Change{Op: DiffChange, Old: removed[k], New: added[k]})
+		}
+		for _, w := range removed[n:] {
+			changes = append(changes, Change{Op: DiffRemove, Old: w})
+		}
+		for _, w := range added[n:] {
+			changes = append(changes, Change{Op: DiffAdd, New: w})
+		}
+		removed, added = removed[:0], added[:0]
+	}
+
+	i, j := 0, 0
+	for i < len(x) || j < len(y) {
+		switch {
+		case i < len(x) && j < len(y) && xl[i] == yl[j]:
+			flush()
+			changes = append(changes, Change{Op: DiffKeep, Old: x[i], New: y[j]})
+			i++
+			j++
+		case j == len(y) || (i < len(x) && lcs[i+1][j] >= lcs[i][j+1]):
+			removed = append(removed, x[i])
+			i++
+		default:
+			added = append(added, y[j])
+			j++
+		}
+	}
+	flush()
+
+	return changes
+}
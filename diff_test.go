@@ -0,0 +1,56 @@
+package stringcases_test
+
+import (
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	type change = stringcases.Change
+
+	tests := []struct {
+		name string
+		a, b string
+		want []change
+	}{
+		{"empty", "", "", nil},
+		{"same", "userID", "user_id", []change{
+			{Op: stringcases.DiffKeep, Old: "user", New: "user"},
+			{Op: stringcases.DiffKeep, Old: "ID", New: "id"},
+		}},
+		{"add", "userID", "user_name_id", []change{
+			{Op: stringcases.DiffKeep, Old: "user", New: "user"},
+			{Op: stringcases.DiffAdd, New: "name"},
+			{Op: stringcases.DiffKeep, Old: "ID", New: "id"},
+		}},
+		{"remove", "created-at-time", "createdAt", []change{
+			{Op: stringcases.DiffKeep, Old: "created", New: "created"},
+			{Op: stringcases.DiffKeep, Old: "at", New: "At"},
+			{Op: stringcases.DiffRemove, Old: "time"},
+		}},
+		{"change", "UserName", "user_email", []change{
+			{Op: stringcases.DiffKeep, Old: "User", New: "user"},
+			{Op: stringcases.DiffChange, Old: "Name", New: "email"},
+		}},
+		{"change and add", "billingAddr", "shipping_address_line", []change{
+			{Op: stringcases.DiffChange, Old: "billing", New: "shipping"},
+			{Op: stringcases.DiffChange, Old: "Addr", New: "address"},
+			{Op: stringcases.DiffAdd, New: "line"},
+		}},
+		{"from nothing", "", "id", []change{
+			{Op: stringcases.DiffAdd, New: "id"},
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.Diff(test.a, test.b))
+		})
+	}
+
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "change", stringcases.DiffChange.String())
+	})
+}
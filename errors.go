@@ -0,0 +1,36 @@
+package stringcases
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrAmbiguous               = errors.New("stringcases: ambiguous result")
+	ErrConflictingNumberPolicy = errors.New("stringcases: conflicting number policy")
+	ErrConflictingOptions      = errors.New("stringcases: conflicting options")
+	ErrDisallowedRune          = errors.New("stringcases: disallowed rune")
+	ErrEmpty                   = errors.New("stringcases: empty result")
+	ErrInvalidName             = errors.New("stringcases: invalid name")
+	ErrInvalidOption           = errors.New("stringcases: invalid option")
+	ErrInvalidPrefix           = errors.New("stringcases: invalid prefix")
+	ErrInvalidSeparator        = errors.New("stringcases: invalid separator")
+	ErrInvalidUTF8             = errors.New("stringcases: invalid UTF-8")
+	ErrLeadingInitialism       = errors.New("stringcases: leading initialism")
+	ErrUnknownCase             = errors.New("stringcases: unknown case")
+)
+
+// InputError reports the offending rune and its byte offset in the input.
+type InputError struct {
+	Offset int
+	Rune   rune
+	Err    error
+}
+
+func (e *InputError) Error() string {
+	return fmt.Sprintf("%s: %q at byte %d", e.Err, e.Rune, e.Offset)
+}
+
+func (e *InputError) Unwrap() error {
+	return e.Err
+}
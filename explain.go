@@ -0,0 +1,140 @@
+package stringcases
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+var ExplainTokenize = s.ExplainTokenize
+
+// rule is the way the tokenizer found a word.
+type rule int
+
+const (
+	ruleNone rule = iota
+	ruleLower
+	ruleNumber
+	ruleUpper
+	ruleCamel
+	ruleInitialism
+	ruleSpecialWord
+	ruleVersion
+	ruleNumericPattern
+	ruleUnit
+	ruleCJK
+	ruleEmoji
+	rulePlaceholder
+	ruleTokenizer
+	ruleDelimiters
+	ruleExpansion
+	ruleAbbreviation
+)
+
+var ruleNames = [...]string{
+	ruleNone:           "",
+	ruleLower:          "lowercase run",
+	ruleNumber:         "number",
+	ruleUpper:          "uppercase run",
+	ruleCamel:          "capitalized word",
+	ruleInitialism:     "initialism",
+	ruleSpecialWord:    "special word",
+	ruleVersion:        "version",
+	ruleNumericPattern: "numeric pattern",
+	ruleUnit:           "number with unit",
+	ruleCJK:            "CJK run",
+	ruleEmoji:          "emoji",
+	rulePlaceholder:    "symbol placeholder",
+	ruleTokenizer:      "custom tokenizer",
+	ruleDelimiters:     "delimiter run",
+	ruleExpansion:      "expansion",
+	ruleAbbreviation:   "abbreviation",
+}
+
+func (r rule) String() string {
+	return ruleNames[r]
+}
+
+// upperRule returns the rule for a word that starts with an uppercase letter.
+func (str *String) upperRule(token string) rule {
+	u := str.upper(token)
+	if str.isInitialism(u) {
+		return ruleInitialism
+	}
+	if stem, ok := strings.CutSuffix(token, "s"); ok && str.isInitialism(str.upper(stem)) {
+		return ruleInitialism
+	}
+	if token == u {
+		return ruleUpper
+	}
+
+	return ruleCamel
+}
+
+// Explanation describes how ExplainTokenize found a word.
+type Explanation struct {
+	Span
+
+	// Rule names the rule that found the word, such as "lowercase run",
+	// "capitalized word", "initialism" or "special word".
+	Rule string
+
+	// Boundary tells why the word ended, such as "separator", "case change"
+	// or "end of input". It is empty for the empty words of
+	// WithDelimiterRuns.
+	Boundary string
+}
+
+func (e Explanation) String() string {
+	if e.Boundary == "" {
+		return fmt.Sprintf("%q [%d, %d) %s", e.Word, e.Start, e.End, e.Rule)
+	}
+
+	return fmt.Sprintf("%q [%d, %d) %s, %s", e.Word, e.Start, e.End, e.Rule, e.Boundary)
+}
+
+// ExplainTokenize is like TokenizeSpans, but also tells how every word was
+// found, to help debug custom configurations, e.g.
+//
+//	for _, e := range stringcases.ExplainTokenize("userAPIKey") {
+//		fmt.Println(e)
+//	}
+//	// "user" [0, 4) lowercase run, case change
+//	// "API" [4, 7) initialism, uppercase letter starts the next word
+//	// "Key" [7, 10) capitalized word, end of input
+func (str *String) ExplainTokenize(s string) []Explanation {
+	var explanations []Explanation
+	str.scan(s, func(token string, start, end int, r rule) bool {
+		e := Explanation{Span: Span{Word: token, Start: start, End: end}, Rule: r.String()}
+		if r != ruleDelimiters {
+			e.Boundary = str.boundary(s, start, end)
+		}
+		explanations = append(explanations, e)
+
+		return true
+	})
+
+	return explanations
+}
+
+// boundary tells why the word at s[start:end] ended.
+func (str *String) boundary(s string, start, end int) string {
+	if end >= len(s) {
+		return "end of input"
+	}
+
+	next, _ := utf8.DecodeRuneInString(s[end:])
+	last, _ := utf8.DecodeLastRuneInString(s[start:end])
+	switch {
+	case str.isSeparator(next):
+		return "separator"
+	case str.isNumber(last) != str.isNumber(next):
+		return "number"
+	case str.isUpper(next) && str.isUpper(last):
+		return "uppercase letter starts the next word"
+	case str.isUpper(next):
+		return "case change"
+	default:
+		return "match ends"
+	}
+}
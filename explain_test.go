@@ -0,0 +1,54 @@
+package stringcases_test
+
+import (
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestExplainTokenize(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		assert.Equal(t, []stringcases.Explanation{
+			{Span: stringcases.Span{Word: "user", Start: 0, End: 4}, Rule: "lowercase run", Boundary: "case change"},
+			{Span: stringcases.Span{Word: "API", Start: 4, End: 7}, Rule: "initialism", Boundary: "uppercase letter starts the next word"},
+			{Span: stringcases.Span{Word: "Key", Start: 7, End: 10}, Rule: "capitalized word", Boundary: "separator"},
+			{Span: stringcases.Span{Word: "2", Start: 11, End: 12}, Rule: "number", Boundary: "end of input"},
+		}, stringcases.ExplainTokenize("userAPIKey_2"))
+	})
+
+	t.Run("options", func(t *testing.T) {
+		str := stringcases.New(language.English,
+			stringcases.WithDelimiterRuns(),
+			stringcases.WithVersionTokens(),
+			stringcases.WithSpecialWords("OAuth"),
+			stringcases.WithExpansions(map[string]string{"tz": "time zone"}),
+		)
+
+		var got []string
+		for _, e := range str.ExplainTokenize("OAuth__tz_apiV2") {
+			got = append(got, e.String())
+		}
+
+		assert.Equal(t, []string{
+			`"OAuth" [0, 5) special word, separator`,
+			`"" [7, 7) delimiter run`,
+			`"time" [7, 9) expansion, separator`,
+			`"zone" [7, 9) expansion, separator`,
+			`"api" [10, 13) lowercase run, case change`,
+			`"V2" [13, 15) version, end of input`,
+		}, got)
+	})
+
+	t.Run("words", func(t *testing.T) {
+		assert := assert.New(t)
+
+		text := "HTTPServerIDsList"
+		var words []string
+		for _, e := range stringcases.ExplainTokenize(text) {
+			words = append(words, e.Word)
+		}
+		assert.Equal(stringcases.Tokenize(text), words)
+	})
+}
@@ -0,0 +1,433 @@
+package stringcases
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+var Formatter = s.Formatter
+
+// WordCase is the case applied to a single word.
+type WordCase int
+
+const (
+	WordLower WordCase = iota
+	WordUpper
+	WordTitle
+)
+
+// InitialismPolicy controls when common initialisms are uppercased.
+type InitialismPolicy int
+
+const (
+	// NoInitialisms treats initialisms like any other word.
+	NoInitialisms InitialismPolicy = iota
+
+	// TitleInitialisms uppercases initialisms in title cased words, e.g.
+	// "UserID" instead of "UserId".
+	TitleInitialisms
+
+	// AllInitialisms uppercases initialisms in every word, e.g. "User ID"
+	// instead of "User id".
+	AllInitialisms
+)
+
+// Format describes a case by how its words are cased and joined. The zero
+// value lowercases every word and joins them without a separator.
+type Format struct {
+	// Separator is placed between words.
+	Separator string
+
+	// First is the case of the first word, and Rest is the case of the
+	// remaining words.
+	First, Rest WordCase
+
+	// Initialisms controls when common initialisms are uppercased.
+	Initialisms InitialismPolicy
+}
+
+// Formatter returns a converter for the format, e.g.
+//
+//	toDotted := str.Formatter(Format{Separator: ".", First: WordTitle, Rest: WordTitle})
+//	toDotted("userAPI") // "User.Api"
+func (str *String) Formatter(f Format) func(string) string {
+	return func(s string) string {
+		return str.format(s, f)
+	}
+}
+
+func (str *String) format(s string, f Format) string {
+	if str.maxLength > 0 || str.underscores {
+		// Shortening and underscores need all the words first.
+		out, _ := str.formatTokens(s, str.tokenize(s), f)
+
+		return out
+	}
+
+	if str.fastScan {
+		if t, _ := str.prepare(s); isASCII(t) {
+			var buf [64]byte
+
+			return string(str.appendASCII(buf[:0], t, f, str.hasRomans(s)))
+		}
+	}
+
+	// Most words are at least two bytes long, so the output is rarely longer
+	// than the input with a separator for every other byte.
+	var b strings.Builder
+	b.Grow(len(s) + len(s)/2*len(f.Separator))
+	str.eachWord(s, f, func(i int, w string) {
+		if i > 0 {
+			b.WriteString(f.Separator)
+		}
+		b.WriteString(w)
+	})
+
+	return b.String()
+}
+
+// formatTokens cases the tokens of s and joins them. It also returns the
+// cased words, which the output has verbatim unless WithMaxLength shortened
+// them.
+func (str *String) formatTokens(s string, tokens []string, f Format) (string, []string) {
+	runes := str.caseTokens(s, tokens, f)
+	if !str.underscores {
+		return str.join(runes, f.Separator, str.maxLength, s, f.Rest), runes
+	}
+
+	// Leading and trailing underscores are kept verbatim, e.g. "__init__".
+	prefix := s[:len(s)-len(strings.TrimLeft(s, "_"))]
+	suffix := s[len(strings.TrimRight(s, "_")):]
+	if len(prefix) == len(s) {
+		return s, nil
+	}
+
+	return prefix + str.join(runes, f.Separator, str.maxLength-len(prefix)-len(suffix), s, f.Rest) + suffix, runes
+}
+
+// appendFormat is like format, but appends the output to dst.
+func (str *String) appendFormat(dst []byte, s string, f Format) []byte {
+	if str.maxLength > 0 || str.underscores {
+		// Shortening and underscores need all the words first.
+		return append(dst, str.format(s, f)...)
+	}
+	if str.fastScan {
+		if t, _ := str.prepare(s); isASCII(t) {
+			return str.appendASCII(dst, t, f, str.hasRomans(s))
+		}
+	}
+
+	str.eachWord(s, f, func(i int, w string) {
+		if i > 0 {
+			dst = append(dst, f.Separator...)
+		}
+		dst = append(dst, w...)
+	})
+
+	return dst
+}
+
+// caseTokens cases every token of s in the format.
+func (str *String) caseTokens(s string, tokens []string, f Format) []string {
+	romans := str.hasRomans(s)
+	if str.maxWords > 0 && len(tokens) > str.maxWords {
+		tokens = tokens[:str.maxWords]
+	}
+
+	words := make([]string, len(tokens))
+	for i, token := range tokens {
+		words[i] = str.caseToken(i, token, f, romans)
+	}
+
+	return words
+}
+
+// eachWord calls yield with the cased words of s in order, without
+// collecting the tokens first.
+func (str *String) eachWord(s string, f Format, yield func(i int, word string)) {
+	romans := str.hasRomans(s)
+
+	var i int
+	str.scan(s, func(token string, _, _ int, _ rule) bool {
+		if str.maxWords > 0 && i == str.maxWords {
+			return false
+		}
+
+		yield(i, str.caseToken(i, token, f, romans))
+		i++
+
+		return true
+	})
+}
+
+// hasRomans reports whether Roman numerals are recognized in s. They are
+// only recognized in mixed case input, since words such as "MIX" and "DIV"
+// are valid numerals too.
+func (str *String) hasRomans(s string) bool {
+	return str.romans && strings.IndexFunc(s, unicode.IsLower) >= 0
+}
+
+// caseToken cases the token at index i in the format.
+func (str *String) caseToken(i int, token string, f Format, romans bool) string {
+	wc := f.Rest
+	if i == 0 {
+		wc = f.First
+	}
+
+	w := str.caseWord(token, wc, f.Initialisms)
+	if romans && (f.Initialisms == AllInitialisms || f.Initialisms == TitleInitialisms && wc == WordTitle) && isRomanNumeral(token) {
+		w = token
+	}
+	if i == 0 && wc == WordLower && f.Initialisms != NoInitialisms && str.leading == LeadingLowerFirst {
+		// Only the first letter of a leading initialism is lowercased,
+		// e.g. "IDNumber" becomes "iDNumber" in camel case.
+		if u := str.upper(token); str.isInitialism(u) {
+			r, n := utf8.DecodeRuneInString(u)
+			w = string(unicode.ToLower(r)) + u[n:]
+		}
+	}
+	if str.minimal && str.conforms(token, wc) {
+		w = token
+	}
+	if str.tokenHook != nil {
+		w = str.tokenHook(i, w)
+	}
+	if i > 0 && f.Separator == "" && str.ambiguity == AmbiguityMark && !startsUpper(w) {
+		// Without a separator or an uppercase letter, the boundary would be
+		// lost, e.g. "foo_2_bar" and "foo2_bar" would both become "foo2Bar"
+		// in camel case.
+		w = str.marker + w
+	}
+
+	return w
+}
+
+func (str *String) caseWord(token string, wc WordCase, p InitialismPolicy) string {
+	if str.patterns && matchNumericPattern(token) == len(token) {
+		return token
+	}
+
+	if str.ignoreInitialisms {
+		p = NoInitialisms
+	}
+
+	if p == AllInitialisms || p == TitleInitialisms && wc == WordTitle {
+		w, ok := str.specialWords[strings.ToLower(token)]
+		if !ok {
+			w, ok = str.initialisms.Load().mixed[str.upper(token)]
+		}
+		if ok {
+			if wc == WordTitle {
+				// Title cased words must start uppercase, so "iOS" becomes
+				// "IOS" in Pascal case.
+				r, n := utf8.DecodeRuneInString(w)
+				w = string(unicode.ToUpper(r)) + w[n:]
+			}
+
+			return w
+		}
+		if u := str.upper(token); str.isInitialism(u) {
+			return u
+		}
+		if stem, ok := strings.CutSuffix(token, "s"); ok && wc != WordUpper && str.isInitialism(str.upper(stem)) {
+			// Plural initialisms keep the "s" lowercase, e.g. "UserIDs".
+			return str.upper(stem) + "s"
+		}
+		if r := []rune(token); str.acronyms && len(r) > 1 && isUpper(r) {
+			return token
+		}
+	}
+
+	switch wc {
+	case WordUpper:
+		return str.upper(token)
+	case WordTitle:
+		return str.title(token)
+	default:
+		return str.lower(token)
+	}
+}
+
+// isRomanNumeral reports whether s is an uppercase Roman numeral between 1
+// and 3999, such as "IV" or "XIII".
+func isRomanNumeral(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	s = strings.TrimPrefix(s, "M")
+	s = strings.TrimPrefix(s, "M")
+	s = strings.TrimPrefix(s, "M")
+	for _, digits := range [][]string{
+		{"CM", "CD", "DCCC", "DCC", "DC", "D", "CCC", "CC", "C"},
+		{"XC", "XL", "LXXX", "LXX", "LX", "L", "XXX", "XX", "X"},
+		{"IX", "IV", "VIII", "VII", "VI", "V", "III", "II", "I"},
+	} {
+		for _, d := range digits {
+			if rest, ok := strings.CutPrefix(s, d); ok {
+				s = rest
+
+				break
+			}
+		}
+	}
+
+	return s == ""
+}
+
+func startsUpper(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+
+	return unicode.IsUpper(r)
+}
+
+// conforms reports whether the word is already in the case wc. Title cased
+// words may also be all uppercase, so both "Api" and "API" conform.
+func (str *String) conforms(token string, wc WordCase) bool {
+	switch wc {
+	case WordUpper:
+		return token == str.upper(token)
+	case WordTitle:
+		r, n := utf8.DecodeRuneInString(token)
+		rest := token[n:]
+
+		return unicode.IsUpper(r) && (rest == str.lower(rest) || rest == str.upper(rest))
+	default:
+		return token == str.lower(token)
+	}
+}
+
+// lower lowercases s. Every word is lowercased on its own, so the Greek
+// sigma is fixed up afterwards, see finalSigma.
+func (str *String) lower(s string) string {
+	if str.fastASCII && isASCIIWord(s) {
+		return strings.ToLower(s)
+	}
+
+	return finalSigma(str.lowercase.String(s))
+}
+
+// title uppercases the first letter of s and lowercases the rest. A leading
+// "ß" becomes "ẞ" instead of "Ss" with SharpSCapital, like in upper.
+func (str *String) title(s string) string {
+	if str.fastASCII && isASCIIWord(s) {
+		return asciiTitle(s)
+	}
+	if str.sharpS == SharpSCapital {
+		if i := strings.IndexFunc(s, unicode.IsLetter); i >= 0 && strings.HasPrefix(s[i:], "ß") {
+			return s[:i] + "ẞ" + str.lower(s[i+len("ß"):])
+		}
+	}
+
+	return finalSigma(str.titlecase.String(s))
+}
+
+// finalSigma spells the lowercase Greek sigma as "ς" at the end of a word and
+// as "σ" elsewhere, e.g. "ΟΔΟΣ" lowercases to "οδος", not "οδοσ". Casers
+// cannot tell when a word ends before another word in camel case, or when
+// separators were dropped around it.
+func finalSigma(s string) string {
+	if !strings.ContainsAny(s, "σς") {
+		return s
+	}
+
+	runes := []rune(s)
+	for i, r := range runes {
+		if r != 'σ' && r != 'ς' {
+			continue
+		}
+
+		runes[i] = 'σ'
+		if precededByLetter(runes[:i]) && !followedByLetter(runes[i+1:]) {
+			runes[i] = 'ς'
+		}
+	}
+
+	return string(runes)
+}
+
+// precededByLetter and followedByLetter skip the combining marks next to the
+// sigma, which do not end a word.
+func precededByLetter(runes []rune) bool {
+	for i := len(runes) - 1; i >= 0; i-- {
+		if !unicode.Is(unicode.Mn, runes[i]) {
+			return unicode.IsLetter(runes[i])
+		}
+	}
+
+	return false
+}
+
+func followedByLetter(runes []rune) bool {
+	for _, r := range runes {
+		if !unicode.Is(unicode.Mn, r) {
+			return unicode.IsLetter(r)
+		}
+	}
+
+	return false
+}
+
+// upper uppercases s, spelling "ß" as "ẞ" instead of "SS" with
+// SharpSCapital.
+func (str *String) upper(s string) string {
+	if str.fastASCII && isASCIIWord(s) {
+		return strings.ToUpper(s)
+	}
+	if str.sharpS == SharpSCapital {
+		s = strings.ReplaceAll(s, "ß", "ẞ")
+	}
+
+	return str.uppercase.String(s)
+}
+
+// asciiCasing reports whether the casers of the language case ASCII letters
+// and digits like the Unicode defaults. Turkish and Azerbaijani case the
+// dotted and dotless "i" differently, and Dutch titlecases "ij" as "IJ".
+func asciiCasing(t language.Tag) bool {
+	switch base, _ := t.Base(); base.String() {
+	case "tr", "az", "nl":
+		return false
+	default:
+		return true
+	}
+}
+
+// isASCIIWord reports whether s only has ASCII letters and digits, which
+// are cased without the casers of x/text when the language allows it, see
+// asciiCasing, since most identifiers are plain ASCII.
+func isASCIIWord(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// asciiTitle titlecases the ASCII word s like cases.Title, which uppercases
+// the first letter even after digits, e.g. "2fa" becomes "2Fa".
+func asciiTitle(s string) string {
+	i := strings.IndexFunc(s, unicode.IsLetter)
+	if i < 0 {
+		return s
+	}
+
+	rest := s[i+1:]
+	if c := s[i]; 'A' <= c && c <= 'Z' && rest == strings.ToLower(rest) {
+		return s
+	}
+
+	b := []byte(s)
+	b[i] = byte(unicode.ToUpper(rune(b[i])))
+	for j := i + 1; j < len(b); j++ {
+		b[j] = byte(unicode.ToLower(rune(b[j])))
+	}
+
+	return string(b)
+}
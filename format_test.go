@@ -0,0 +1,32 @@
+package stringcases_test
+
+import (
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatter(t *testing.T) {
+	tests := []struct {
+		scenario string
+		format   stringcases.Format
+		text     string
+		want     string
+	}{
+		{"zero value", stringcases.Format{}, "userAPI", "userapi"},
+		{"dotted title", stringcases.Format{Separator: ".", First: stringcases.WordTitle, Rest: stringcases.WordTitle}, "userAPI", "User.Api"},
+		{"train case", stringcases.Format{Separator: "-", First: stringcases.WordTitle, Rest: stringcases.WordTitle, Initialisms: stringcases.TitleInitialisms}, "user_api_key", "User-API-Key"},
+		{"cobol case", stringcases.Format{Separator: "-", First: stringcases.WordUpper, Rest: stringcases.WordUpper}, "userApiKey", "USER-API-KEY"},
+		{"title initialisms ignore lower words", stringcases.Format{Separator: " ", Rest: stringcases.WordTitle, Initialisms: stringcases.TitleInitialisms}, "id_url", "id URL"},
+		{"all initialisms", stringcases.Format{Separator: " ", Initialisms: stringcases.AllInitialisms}, "id_url_path", "ID URL path"},
+		{"empty", stringcases.Format{Separator: "-"}, "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			f := stringcases.Formatter(test.format)
+			assert.Equal(t, test.want, f(test.text))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package stringcases
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"unicode"
+)
+
+const zwj = '\u200d'
+
+// extendGrapheme continues the grapheme cluster at the end of runes with
+// WithGraphemeClusters. It follows the rules of UAX #29 in a simplified
+// form: combining marks, the zero-width joiner, emoji modifiers and tags
+// extend a cluster, an emoji after a zero-width joiner joins it, and regional
+// indicators pair up into flags.
+func (str *String) extendGrapheme(reader *strings.Reader, runes []rune) []rune {
+	if !str.graphemes {
+		return runes
+	}
+
+	for {
+		r, _, err := reader.ReadRune()
+		if errors.Is(err, io.EOF) {
+			return runes
+		}
+
+		last := runes[len(runes)-1]
+		if !isGraphemeExtend(r) && !(last == zwj && isEmoji(r)) && !(isRegionalIndicator(r) && pairsFlag(runes)) {
+			if err := reader.UnreadRune(); err != nil {
+				panic(err)
+			}
+
+			return runes
+		}
+
+		runes = append(runes, r)
+	}
+}
+
+// clusterStart returns the index of the rune that starts the last grapheme
+// cluster of runes.
+func clusterStart(runes []rune) int {
+	i := len(runes) - 1
+	for i > 0 && isGraphemeExtend(runes[i]) {
+		i--
+	}
+
+	return i
+}
+
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) || r == zwj || isTag(r) ||
+		0x1f3fb <= r && r <= 0x1f3ff
+}
+
+func isRegionalIndicator(r rune) bool {
+	return 0x1f1e6 <= r && r <= 0x1f1ff
+}
+
+func isTag(r rune) bool {
+	return 0xe0020 <= r && r <= 0xe007f
+}
+
+// pairsFlag reports whether runes ends with an odd number of regional
+// indicators, so the next one completes a flag.
+func pairsFlag(runes []rune) bool {
+	var n int
+	for i := len(runes) - 1; i >= 0 && isRegionalIndicator(runes[i]); i-- {
+		n++
+	}
+
+	return n%2 == 1
+}
+
+// joinsEmoji reports whether the invisible rune r continues the emoji prev,
+// such as the zero-width joiner in "👩\u200d💻" or the tags of a subdivision
+// flag.
+func joinsEmoji(prev, r rune) bool {
+	return (r == zwj || isTag(r)) && (isEmoji(prev) || prev == '\ufe0f' || isTag(prev))
+}
+
+// removeInvisible removes the invisible runes of s, see isInvisible, except
+// those that join emoji with WithGraphemeClusters.
+func (str *String) removeInvisible(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	var prev rune
+	for _, r := range s {
+		if str.invisible(prev, r) {
+			continue
+		}
+
+		b.WriteRune(r)
+		prev = r
+	}
+
+	return b.String()
+}
+
+// invisible reports whether r is removed before tokenizing when it follows
+// prev.
+func (str *String) invisible(prev, r rune) bool {
+	return isInvisible(r) && !(str.graphemes && joinsEmoji(prev, r))
+}
@@ -0,0 +1,589 @@
+package stringcases
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	ToMacro  = s.ToMacro
+	BEM      = s.BEM
+	ToCSSVar = s.ToCSSVar
+
+	ToEnv         = s.ToEnv
+	EnvWithPrefix = s.EnvWithPrefix
+
+	ToPrometheusMetric = s.ToPrometheusMetric
+	ToPrometheusLabel  = s.ToPrometheusLabel
+	ToOTelAttr         = s.ToOTelAttr
+	ToProtoEnumValue   = s.ToProtoEnumValue
+
+	ToGoExported   = s.ToGoExported
+	ToGoUnexported = s.ToGoUnexported
+	ToPackageName  = s.ToPackageName
+
+	ToSQLIdentifier = s.ToSQLIdentifier
+
+	AppendMacro            = s.AppendMacro
+	AppendBEM              = s.AppendBEM
+	AppendCSSVar           = s.AppendCSSVar
+	AppendEnv              = s.AppendEnv
+	AppendEnvWithPrefix    = s.AppendEnvWithPrefix
+	AppendPrometheusMetric = s.AppendPrometheusMetric
+	AppendPrometheusLabel  = s.AppendPrometheusLabel
+	AppendOTelAttr         = s.AppendOTelAttr
+	AppendProtoEnumValue   = s.AppendProtoEnumValue
+	AppendGoExported       = s.AppendGoExported
+	AppendGoUnexported     = s.AppendGoUnexported
+	AppendPackageName      = s.AppendPackageName
+	AppendSQLIdentifier    = s.AppendSQLIdentifier
+)
+
+// SQLDialect describes how a database quotes and folds identifiers.
+type SQLDialect struct {
+	Name string
+
+	// Quote and Unquote enclose identifiers that collide with reserved
+	// words, e.g. `"` and `"` for ANSI SQL.
+	Quote, Unquote string
+
+	// Upper folds identifiers to uppercase instead of lowercase.
+	Upper bool
+
+	// Reserved holds the reserved words of the dialect, in addition to the
+	// ones shared by all dialects.
+	Reserved map[string]bool
+}
+
+var (
+	Postgres = SQLDialect{
+		Name:    "postgres",
+		Quote:   `"`,
+		Unquote: `"`,
+		Reserved: map[string]bool{
+			"analyse": true, "analyze": true, "array": true, "both": true,
+			"leading": true, "only": true, "returning": true,
+			"trailing": true, "window": true,
+		},
+	}
+	MySQL = SQLDialect{
+		Name:    "mysql",
+		Quote:   "`",
+		Unquote: "`",
+		Reserved: map[string]bool{
+			"database": true, "databases": true, "div": true,
+			"interval": true, "keys": true, "match": true, "mod": true,
+			"rank": true, "read": true, "regexp": true, "rlike": true,
+			"schema": true, "show": true,
+		},
+	}
+	SQLite = SQLDialect{
+		Name:    "sqlite",
+		Quote:   `"`,
+		Unquote: `"`,
+		Reserved: map[string]bool{
+			"abort": true, "autoincrement": true, "glob": true,
+			"indexed": true, "notnull": true, "pragma": true,
+			"regexp": true, "vacuum": true,
+		},
+	}
+	MSSQL = SQLDialect{
+		Name:    "mssql",
+		Quote:   "[",
+		Unquote: "]",
+		Reserved: map[string]bool{
+			"backup": true, "browse": true, "bulk": true,
+			"clustered": true, "file": true, "identity": true,
+			"percent": true, "plan": true, "print": true, "proc": true,
+			"public": true, "rule": true, "tran": true,
+		},
+	}
+)
+
+// sqlReserved is a set of words reserved in at least one supported SQL
+// dialect.
+var sqlReserved = map[string]bool{
+	"all":               true,
+	"alter":             true,
+	"and":               true,
+	"any":               true,
+	"as":                true,
+	"asc":               true,
+	"between":           true,
+	"by":                true,
+	"case":              true,
+	"check":             true,
+	"column":            true,
+	"constraint":        true,
+	"create":            true,
+	"cross":             true,
+	"current_date":      true,
+	"current_time":      true,
+	"current_timestamp": true,
+	"current_user":      true,
+	"default":           true,
+	"delete":            true,
+	"desc":              true,
+	"distinct":          true,
+	"drop":              true,
+	"else":              true,
+	"end":               true,
+	"except":            true,
+	"exists":            true,
+	"false":             true,
+	"fetch":             true,
+	"for":               true,
+	"foreign":           true,
+	"from":              true,
+	"full":              true,
+	"grant":             true,
+	"group":             true,
+	"having":            true,
+	"in":                true,
+	"index":             true,
+	"inner":             true,
+	"insert":            true,
+	"intersect":         true,
+	"into":              true,
+	"is":                true,
+	"join":              true,
+	"key":               true,
+	"left":              true,
+	"like":              true,
+	"limit":             true,
+	"not":               true,
+	"null":              true,
+	"offset":            true,
+	"on":                true,
+	"or":                true,
+	"order":             true,
+	"outer":             true,
+	"primary":           true,
+	"references":        true,
+	"right":             true,
+	"select":            true,
+	"session_user":      true,
+	"set":               true,
+	"table":             true,
+	"then":              true,
+	"to":                true,
+	"true":              true,
+	"union":             true,
+	"unique":            true,
+	"update":            true,
+	"user":              true,
+	"using":             true,
+	"values":            true,
+	"when":              true,
+	"where":             true,
+	"with":              true,
+}
+
+var goKeywords = map[string]bool{
+	"break":       true,
+	"case":        true,
+	"chan":        true,
+	"const":       true,
+	"continue":    true,
+	"default":     true,
+	"defer":       true,
+	"else":        true,
+	"fallthrough": true,
+	"for":         true,
+	"func":        true,
+	"go":          true,
+	"goto":        true,
+	"if":          true,
+	"import":      true,
+	"interface":   true,
+	"map":         true,
+	"package":     true,
+	"range":       true,
+	"return":      true,
+	"select":      true,
+	"struct":      true,
+	"switch":      true,
+	"type":        true,
+	"var":         true,
+}
+
+// ToMacro converts the string into a macro name with the given prefix, e.g.
+// ToMacro("myapp", "userAPI") returns "MYAPP_USER_API". The prefix must be
+// a valid identifier, and trailing underscores on it are collapsed.
+func (str *String) ToMacro(prefix, s string) (string, error) {
+	name, err := str.AppendMacro(nil, prefix, s)
+
+	return string(name), err
+}
+
+// AppendMacro is like ToMacro, but appends to dst, see AppendSnake. On error
+// dst is returned unchanged.
+func (str *String) AppendMacro(dst []byte, prefix, s string) ([]byte, error) {
+	return str.appendPrefix(dst, prefix, func(dst []byte) []byte {
+		return str.AppendDelimitedUpper(dst, s, "_")
+	})
+}
+
+// ToEnv converts the string into an environment variable name matching
+// [A-Z_][A-Z0-9_]*, e.g. "dbHost" becomes "DB_HOST". Illegal characters are
+// replaced with underscores, and a leading digit is prefixed with one.
+func (str *String) ToEnv(s string) string {
+	return string(str.AppendEnv(nil, s))
+}
+
+// AppendEnv is like ToEnv, but appends to dst, see AppendSnake.
+func (str *String) AppendEnv(dst []byte, s string) []byte {
+	return escapeDigit(str.appendEnv(dst, s), len(dst))
+}
+
+// appendEnv appends the words of the environment variable name, without
+// escaping a leading digit.
+func (str *String) appendEnv(dst []byte, s string) []byte {
+	return squeeze(str.AppendDelimitedUpper(dst, s, "_"), len(dst), '_', func(r rune) bool {
+		return 'A' <= r && r <= 'Z' || '0' <= r && r <= '9'
+	})
+}
+
+// EnvWithPrefix is like ToEnv, but prepends the prefix, e.g.
+// EnvWithPrefix("MYAPP", "dbHost") returns "MYAPP_DB_HOST".
+func (str *String) EnvWithPrefix(prefix, s string) (string, error) {
+	name, err := str.AppendEnvWithPrefix(nil, prefix, s)
+
+	return string(name), err
+}
+
+// AppendEnvWithPrefix is like EnvWithPrefix, but appends to dst, see
+// AppendSnake. On error dst is returned unchanged.
+func (str *String) AppendEnvWithPrefix(dst []byte, prefix, s string) ([]byte, error) {
+	return str.appendPrefix(dst, prefix, func(dst []byte) []byte {
+		return str.appendEnv(dst, s)
+	})
+}
+
+// ToPrometheusMetric converts the string into a Prometheus metric name
+// matching [a-zA-Z_:][a-zA-Z0-9_:]*, e.g. "httpRequestsTotal" becomes
+// "http_requests_total".
+func (str *String) ToPrometheusMetric(s string) string {
+	return string(str.AppendPrometheusMetric(nil, s))
+}
+
+// AppendPrometheusMetric is like ToPrometheusMetric, but appends to dst, see
+// AppendSnake.
+func (str *String) AppendPrometheusMetric(dst []byte, s string) []byte {
+	start := len(dst)
+
+	return escapeDigit(squeeze(str.AppendSnake(dst, s), start, '_', func(r rune) bool {
+		return r == ':' || isAlnum(r)
+	}), start)
+}
+
+// ToPrometheusLabel converts the string into a Prometheus label name
+// matching [a-zA-Z_][a-zA-Z0-9_]*, e.g. "statusCode" becomes "status_code".
+// Label names starting with "__" are reserved, so they never do.
+func (str *String) ToPrometheusLabel(s string) string {
+	return string(str.AppendPrometheusLabel(nil, s))
+}
+
+// AppendPrometheusLabel is like ToPrometheusLabel, but appends to dst, see
+// AppendSnake.
+func (str *String) AppendPrometheusLabel(dst []byte, s string) []byte {
+	start := len(dst)
+
+	return escapeDigit(squeeze(str.AppendSnake(dst, s), start, '_', isAlnum), start)
+}
+
+// ToOTelAttr converts the namespace and name into an OpenTelemetry attribute
+// key, e.g. ToOTelAttr("http.request", "Method") returns
+// "http.request.method". Each dot-separated namespace and the name are snake
+// cased.
+func (str *String) ToOTelAttr(namespace, name string) string {
+	return string(str.AppendOTelAttr(nil, namespace, name))
+}
+
+// AppendOTelAttr is like ToOTelAttr, but appends to dst, see AppendSnake.
+func (str *String) AppendOTelAttr(dst []byte, namespace, name string) []byte {
+	start := len(dst)
+	for _, p := range append(strings.Split(namespace, "."), name) {
+		n := len(dst)
+		if n > start {
+			dst = append(dst, '.')
+		}
+		m := len(dst)
+		if dst = str.AppendSnake(dst, p); len(dst) == m {
+			dst = dst[:n]
+		}
+	}
+
+	return dst
+}
+
+// ToProtoEnumValue converts the value into a protobuf enum value name
+// prefixed with the enum name, e.g. ToProtoEnumValue("UserStatus", "active")
+// returns "USER_STATUS_ACTIVE". A value that already carries the prefix is
+// not prefixed again.
+func (str *String) ToProtoEnumValue(enumName, valueName string) string {
+	return string(str.AppendProtoEnumValue(nil, enumName, valueName))
+}
+
+// AppendProtoEnumValue is like ToProtoEnumValue, but appends to dst, see
+// AppendSnake.
+func (str *String) AppendProtoEnumValue(dst []byte, enumName, valueName string) []byte {
+	start := len(dst)
+	dst = str.AppendDelimitedUpper(dst, enumName, "_")
+	if len(dst) == start {
+		return str.AppendDelimitedUpper(dst, valueName, "_")
+	}
+
+	n := len(dst)
+	dst = str.AppendDelimitedUpper(append(dst, '_'), valueName, "_")
+	prefix, value := dst[start:n], dst[n+1:]
+	switch {
+	case len(value) == 0, bytes.Equal(value, prefix):
+		return dst[:n]
+	case len(value) > len(prefix) && bytes.HasPrefix(value, prefix) && value[len(prefix)] == '_':
+		return dst[:start+copy(dst[start:], value)]
+	}
+
+	return dst
+}
+
+// ToGoExported converts the string into an exported Go identifier, e.g.
+// "user_api" becomes "UserAPI". A leading digit is prefixed with "X".
+func (str *String) ToGoExported(s string) string {
+	return string(str.AppendGoExported(nil, s))
+}
+
+// AppendGoExported is like ToGoExported, but appends to dst, see
+// AppendSnake.
+func (str *String) AppendGoExported(dst []byte, s string) []byte {
+	start := len(dst)
+	dst = goIdentifier(str.AppendPascal(dst, s), start)
+	if r, _ := utf8.DecodeRune(dst[start:]); len(dst) > start && !unicode.IsLetter(r) {
+		dst = slices.Insert(dst, start, 'X')
+	}
+
+	return dst
+}
+
+// ToGoUnexported converts the string into an unexported Go identifier, e.g.
+// "UserAPI" becomes "userAPI". A leading digit is prefixed with "x", and
+// keywords get an underscore appended, so "type" becomes "type_".
+func (str *String) ToGoUnexported(s string) string {
+	return string(str.AppendGoUnexported(nil, s))
+}
+
+// AppendGoUnexported is like ToGoUnexported, but appends to dst, see
+// AppendSnake.
+func (str *String) AppendGoUnexported(dst []byte, s string) []byte {
+	start := len(dst)
+	dst = goIdentifier(str.AppendCamel(dst, s), start)
+	if r, _ := utf8.DecodeRune(dst[start:]); len(dst) > start && !unicode.IsLetter(r) {
+		dst = slices.Insert(dst, start, 'x')
+	}
+	if goKeywords[string(dst[start:])] {
+		dst = append(dst, '_')
+	}
+
+	return dst
+}
+
+// ToSQLIdentifier converts the string into a snake case SQL identifier for
+// the dialect, e.g. "userAccounts" becomes "user_accounts". Identifiers that
+// collide with reserved words or start with a digit are quoted, so Order
+// becomes "order" in Postgres and `order` in MySQL.
+func (str *String) ToSQLIdentifier(s string, d SQLDialect) string {
+	return string(str.AppendSQLIdentifier(nil, s, d))
+}
+
+// AppendSQLIdentifier is like ToSQLIdentifier, but appends to dst, see
+// AppendSnake.
+func (str *String) AppendSQLIdentifier(dst []byte, s string, d SQLDialect) []byte {
+	start := len(dst)
+	dst = str.AppendSnake(dst, s)
+	if len(dst) == start {
+		return dst
+	}
+
+	if d.Upper {
+		dst = append(dst[:start], str.upper(string(dst[start:]))...)
+	}
+
+	l := strings.ToLower(string(dst[start:]))
+	if r, _ := utf8.DecodeRune(dst[start:]); sqlReserved[l] || d.Reserved[l] || unicode.IsDigit(r) {
+		dst = append(slices.Insert(dst, start, []byte(d.Quote)...), d.Unquote...)
+	}
+
+	return dst
+}
+
+// ToPackageName converts the string into an idiomatic Go package name, e.g.
+// "UserAPIClient" becomes "userapiclient". Leading digits are dropped, and
+// keywords get "pkg" appended, so "type" becomes "typepkg".
+func (str *String) ToPackageName(s string) string {
+	return string(str.AppendPackageName(nil, s))
+}
+
+// AppendPackageName is like ToPackageName, but appends to dst, see
+// AppendSnake.
+func (str *String) AppendPackageName(dst []byte, s string) []byte {
+	start := len(dst)
+	dst = goIdentifier(str.AppendDelimited(dst, s, ""), start)
+	dst = dst[:start+copy(dst[start:], bytes.TrimLeftFunc(dst[start:], unicode.IsDigit))]
+	if goKeywords[string(dst[start:])] {
+		dst = append(dst, "pkg"...)
+	}
+
+	return dst
+}
+
+// goIdentifier drops the runes of dst[start:] that are not allowed in Go
+// identifiers.
+func goIdentifier(dst []byte, start int) []byte {
+	return filter(dst, start, func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	})
+}
+
+// appendPrefix validates and uppercases the prefix, then joins it with the
+// body appended by fn using a single underscore.
+func (str *String) appendPrefix(dst []byte, prefix string, fn func([]byte) []byte) ([]byte, error) {
+	prefix = strings.TrimRight(prefix, "_")
+	if !isIdentifier(prefix) {
+		return dst, fmt.Errorf("%w: %q", ErrInvalidPrefix, prefix)
+	}
+
+	dst = append(dst, str.uppercase.String(prefix)...)
+	n := len(dst)
+	if dst = fn(append(dst, '_')); len(dst) == n+1 {
+		dst = dst[:n]
+	}
+
+	return dst, nil
+}
+
+// BEM builds a CSS class name following the BEM convention, e.g.
+// BEM("searchForm", "submitButton", "isDisabled") returns
+// "search-form__submit-button--is-disabled". Empty parts are omitted.
+func (str *String) BEM(block, element, modifier string) string {
+	return string(str.AppendBEM(nil, block, element, modifier))
+}
+
+// AppendBEM is like BEM, but appends to dst, see AppendSnake.
+func (str *String) AppendBEM(dst []byte, block, element, modifier string) []byte {
+	dst = str.AppendKebab(dst, block)
+	for _, p := range [][2]string{{"__", element}, {"--", modifier}} {
+		n := len(dst)
+		if dst = str.AppendKebab(append(dst, p[0]...), p[1]); len(dst) == n+len(p[0]) {
+			dst = dst[:n]
+		}
+	}
+
+	return dst
+}
+
+// ToCSSVar converts the string into a CSS custom property name, e.g.
+// "userAPIColor" becomes "--user-api-color". It returns ErrEmpty when the
+// string has no words.
+func (str *String) ToCSSVar(s string) (string, error) {
+	name, err := str.AppendCSSVar(nil, s)
+
+	return string(name), err
+}
+
+// AppendCSSVar is like ToCSSVar, but appends to dst, see AppendSnake. On
+// error dst is returned unchanged.
+func (str *String) AppendCSSVar(dst []byte, s string) ([]byte, error) {
+	start := len(dst)
+	dst = str.AppendKebab(append(dst, "--"...), s)
+	name := dst[start+len("--"):]
+	if len(name) == 0 {
+		return dst[:start], ErrEmpty
+	}
+
+	for _, r := range string(name) {
+		switch {
+		case r == '-', r == '_', r >= 0x80:
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		default:
+			return dst[:start], fmt.Errorf("%w: %q is not a CSS identifier", ErrInvalidName, name)
+		}
+	}
+
+	return dst, nil
+}
+
+// squeeze replaces every run of runes of dst[start:] that are not valid with
+// a single sep, and trims sep from both ends. The runes only move down, so
+// dst is rewritten in place.
+func squeeze(dst []byte, start int, sep byte, valid func(rune) bool) []byte {
+	n, pending := start, false
+	for i := start; i < len(dst); {
+		r, size := utf8.DecodeRune(dst[i:])
+		if r == rune(sep) || !valid(r) {
+			pending = n > start
+			i += size
+			continue
+		}
+
+		if pending {
+			dst[n] = sep
+			n++
+			pending = false
+		}
+		n += copy(dst[n:], dst[i:i+size])
+		i += size
+	}
+
+	return dst[:n]
+}
+
+// filter drops the runes of dst[start:] that are not valid, in place.
+func filter(dst []byte, start int, valid func(rune) bool) []byte {
+	n := start
+	for i := start; i < len(dst); {
+		r, size := utf8.DecodeRune(dst[i:])
+		if valid(r) {
+			n += copy(dst[n:], dst[i:i+size])
+		}
+		i += size
+	}
+
+	return dst[:n]
+}
+
+// escapeDigit prefixes dst[start:] with an underscore when it starts with a
+// digit.
+func escapeDigit(dst []byte, start int) []byte {
+	if len(dst) > start && '0' <= dst[start] && dst[start] <= '9' {
+		return slices.Insert(dst, start, '_')
+	}
+
+	return dst
+}
+
+// isAlnum reports whether r matches [a-zA-Z0-9].
+func isAlnum(r rune) bool {
+	return 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9'
+}
+
+// isIdentifier reports whether s matches [A-Za-z_][A-Za-z0-9_]*.
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i, r := range s {
+		switch {
+		case r == '_', 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		case i > 0 && '0' <= r && r <= '9':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
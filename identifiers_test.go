@@ -0,0 +1,283 @@
+package stringcases_test
+
+import (
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToMacro(t *testing.T) {
+	tests := []struct {
+		scenario string
+		prefix   string
+		text     string
+		want     string
+	}{
+		{"camel", "myapp", "userAPI", "MYAPP_USER_API"},
+		{"trailing underscore", "MYAPP__", "_user_api", "MYAPP_USER_API"},
+		{"empty body", "MYAPP", "", "MYAPP"},
+		{"digits", "app2", "maxConns", "APP2_MAX_CONNS"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert := assert.New(t)
+
+			got, err := stringcases.ToMacro(test.prefix, test.text)
+			assert.NoError(err)
+			assert.Equal(test.want, got)
+		})
+	}
+
+	t.Run("invalid prefix", func(t *testing.T) {
+		for _, prefix := range []string{"", "_", "my-app", "2app", "é"} {
+			_, err := stringcases.ToMacro(prefix, "userAPI")
+			assert.ErrorIs(t, err, stringcases.ErrInvalidPrefix, prefix)
+		}
+	})
+}
+
+func TestBEM(t *testing.T) {
+	tests := []struct {
+		scenario string
+		block    string
+		element  string
+		modifier string
+		want     string
+	}{
+		{"block", "SearchForm", "", "", "search-form"},
+		{"element", "SearchForm", "submitButton", "", "search-form__submit-button"},
+		{"modifier", "SearchForm", "", "isDisabled", "search-form--is-disabled"},
+		{"all", "searchForm", "submit_button", "isDisabled", "search-form__submit-button--is-disabled"},
+		{"initialism", "UserAPI", "HTMLInput", "", "user-api__html-input"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.BEM(test.block, test.element, test.modifier))
+		})
+	}
+}
+
+func TestToCSSVar(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		want     string
+	}{
+		{"camel", "userAPIColor", "--user-api-color"},
+		{"snake", "primary_bg_2", "--primary-bg-2"},
+		{"leading digit", "2xlSpacing", "--2xl-spacing"},
+		{"unicode", "café", "--café"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert := assert.New(t)
+
+			got, err := stringcases.ToCSSVar(test.text)
+			assert.NoError(err)
+			assert.Equal(test.want, got)
+		})
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := stringcases.ToCSSVar("--")
+		assert.ErrorIs(t, err, stringcases.ErrEmpty)
+	})
+}
+
+func TestToEnv(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		want     string
+	}{
+		{"camel", "dbHost", "DB_HOST"},
+		{"kebab", "http-proxy", "HTTP_PROXY"},
+		{"leading digit", "2faEnabled", "_2FA_ENABLED"},
+		{"non ascii", "caféMenu", "CAF_MENU"},
+		{"empty", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToEnv(test.text))
+		})
+	}
+}
+
+func TestEnvWithPrefix(t *testing.T) {
+	tests := []struct {
+		scenario string
+		prefix   string
+		text     string
+		want     string
+	}{
+		{"camel", "MYAPP", "dbHost", "MYAPP_DB_HOST"},
+		{"lowercase prefix", "myapp_", "dbHost", "MYAPP_DB_HOST"},
+		{"leading digit", "MYAPP", "2faEnabled", "MYAPP_2FA_ENABLED"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert := assert.New(t)
+
+			got, err := stringcases.EnvWithPrefix(test.prefix, test.text)
+			assert.NoError(err)
+			assert.Equal(test.want, got)
+		})
+	}
+
+	t.Run("invalid prefix", func(t *testing.T) {
+		_, err := stringcases.EnvWithPrefix("MY APP", "dbHost")
+		assert.ErrorIs(t, err, stringcases.ErrInvalidPrefix)
+	})
+}
+
+func TestToPrometheus(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		metric   string
+		label    string
+	}{
+		{"camel", "httpRequestsTotal", "http_requests_total", "http_requests_total"},
+		{"kebab", "status-code", "status_code", "status_code"},
+		{"leading digit", "5xxErrors", "_5xx_errors", "_5xx_errors"},
+		{"leading underscores", "__name__", "name", "name"},
+		{"non ascii", "caféVisits", "caf_visits", "caf_visits"},
+		{"empty", "", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(test.metric, stringcases.ToPrometheusMetric(test.text))
+			assert.Equal(test.label, stringcases.ToPrometheusLabel(test.text))
+		})
+	}
+}
+
+func TestToOTelAttr(t *testing.T) {
+	tests := []struct {
+		scenario  string
+		namespace string
+		name      string
+		want      string
+	}{
+		{"semconv", "http.request", "Method", "http.request.method"},
+		{"camel namespace", "dbClient.connections", "maxIdle", "db_client.connections.max_idle"},
+		{"initialism", "http", "responseStatusCode", "http.response_status_code"},
+		{"empty namespace", "", "userID", "user_id"},
+		{"empty segments", "..service.", "instanceId", "service.instance_id"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToOTelAttr(test.namespace, test.name))
+		})
+	}
+}
+
+func TestToProtoEnumValue(t *testing.T) {
+	tests := []struct {
+		scenario string
+		enum     string
+		value    string
+		want     string
+	}{
+		{"pascal", "UserStatus", "active", "USER_STATUS_ACTIVE"},
+		{"unspecified", "UserStatus", "Unspecified", "USER_STATUS_UNSPECIFIED"},
+		{"already prefixed", "UserStatus", "USER_STATUS_ACTIVE", "USER_STATUS_ACTIVE"},
+		{"similar prefix", "UserStatus", "userStatuses", "USER_STATUS_USER_STATUSES"},
+		{"initialism", "APIVersion", "v2", "API_VERSION_V2"},
+		{"empty enum", "", "active", "ACTIVE"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToProtoEnumValue(test.enum, test.value))
+		})
+	}
+}
+
+func TestToGoIdentifier(t *testing.T) {
+	tests := []struct {
+		scenario   string
+		text       string
+		exported   string
+		unexported string
+	}{
+		{"snake", "user_api", "UserAPI", "userAPI"},
+		{"leading initialism", "HTTPServer", "HTTPServer", "httpServer"},
+		{"leading digit", "2fa_enabled", "X2FaEnabled", "x2faEnabled"},
+		{"keyword", "type", "Type", "type_"},
+		{"keyword in phrase", "func name", "FuncName", "funcName"},
+		{"non digit number", "area²", "Area", "area"},
+		{"empty", "", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(test.exported, stringcases.ToGoExported(test.text))
+			assert.Equal(test.unexported, stringcases.ToGoUnexported(test.text))
+		})
+	}
+}
+
+func TestToSQLIdentifier(t *testing.T) {
+	upper := stringcases.Postgres
+	upper.Upper = true
+
+	tests := []struct {
+		scenario string
+		text     string
+		dialect  stringcases.SQLDialect
+		want     string
+	}{
+		{"postgres", "userAccounts", stringcases.Postgres, "user_accounts"},
+		{"postgres reserved", "Order", stringcases.Postgres, `"order"`},
+		{"postgres dialect reserved", "returning", stringcases.Postgres, `"returning"`},
+		{"mysql reserved", "user", stringcases.MySQL, "`user`"},
+		{"mysql dialect reserved", "schema", stringcases.MySQL, "`schema`"},
+		{"sqlite dialect reserved", "vacuum", stringcases.SQLite, `"vacuum"`},
+		{"mssql reserved", "group", stringcases.MSSQL, "[group]"},
+		{"mssql not reserved", "schema", stringcases.MSSQL, "schema"},
+		{"leading digit", "2fa", stringcases.Postgres, `"2fa"`},
+		{"upper", "userAccounts", upper, "USER_ACCOUNTS"},
+		{"upper reserved", "select", upper, `"SELECT"`},
+		{"empty", "", stringcases.Postgres, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToSQLIdentifier(test.text, test.dialect))
+		})
+	}
+}
+
+func TestToPackageName(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		want     string
+	}{
+		{"pascal", "UserAPIClient", "userapiclient"},
+		{"snake", "user_service", "userservice"},
+		{"leading digit", "3dRenderer", "drenderer"},
+		{"keyword", "Type", "typepkg"},
+		{"digits only", "2024", ""},
+		{"empty", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToPackageName(test.text))
+		})
+	}
+}
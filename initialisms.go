@@ -0,0 +1,400 @@
+package stringcases
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// LoadInitialisms parses a list of initialisms for WithInitialisms. The list
+// is either a JSON array of strings, a YAML flow sequence, or a YAML block
+// sequence with one entry per line:
+//
+//	# initialisms.yaml
+//	- GRPC
+//	- SKU
+//
+// Entries may be quoted and must be made up of letters and digits. Words
+// without uppercase letters are uppercased, while mixed case words such as
+// "IPv6" keep their spelling. Other YAML, such as mappings, nested sequences,
+// anchors and multiple documents, is rejected with ErrInvalidOption.
+func LoadInitialisms(r io.Reader) (map[string]bool, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var words []string
+	if t := bytes.TrimSpace(b); bytes.HasPrefix(t, []byte("[")) {
+		if err := json.Unmarshal(t, &words); err != nil {
+			words, err = parseFlowSequence(t)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		words, err = parseBlockSequence(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	m := make(map[string]bool, len(words))
+	for _, w := range words {
+		if !isInitialismWord(w) {
+			return nil, fmt.Errorf("%w: initialism %q", ErrInvalidOption, w)
+		}
+
+		if strings.IndexFunc(w, unicode.IsUpper) < 0 {
+			w = strings.ToUpper(w)
+		}
+		m[w] = true
+	}
+
+	return m, nil
+}
+
+// parseFlowSequence parses a YAML flow sequence such as [GRPC, "SKU"], which
+// may span lines and end with a comma.
+func parseFlowSequence(b []byte) ([]string, error) {
+	var sb strings.Builder
+	for _, line := range strings.Split(string(b), "\n") {
+		sb.WriteString(stripComment(line))
+		sb.WriteByte(' ')
+	}
+
+	s := strings.TrimSpace(sb.String())
+	if !strings.HasSuffix(s, "]") {
+		return nil, fmt.Errorf("%w: unterminated initialism sequence", ErrInvalidOption)
+	}
+
+	s = strings.TrimSpace(s[1 : len(s)-1])
+	if s == "" {
+		return nil, nil
+	}
+
+	entries := strings.Split(s, ",")
+	if strings.TrimSpace(entries[len(entries)-1]) == "" {
+		entries = entries[:len(entries)-1]
+	}
+
+	var words []string
+	for _, e := range entries {
+		w, err := unquote(e)
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, w)
+	}
+
+	return words, nil
+}
+
+// parseBlockSequence parses a YAML block sequence with one "- WORD" entry
+// per line, all at the same indentation. Comments are skipped, and the list
+// may start with a document marker.
+func parseBlockSequence(b []byte) ([]string, error) {
+	var words []string
+	indent := -1
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for n := 1; scanner.Scan(); n++ {
+		text := stripComment(scanner.Text())
+		line := strings.TrimSpace(text)
+		if line == "" || line == "---" && indent < 0 {
+			continue
+		}
+
+		w, ok := strings.CutPrefix(line, "- ")
+		if !ok {
+			return nil, fmt.Errorf("%w: line %d: expected \"- WORD\", got %q", ErrInvalidOption, n, line)
+		}
+
+		if i := len(text) - len(strings.TrimLeft(text, " ")); indent < 0 {
+			indent = i
+		} else if i != indent {
+			return nil, fmt.Errorf("%w: line %d: unexpected indentation", ErrInvalidOption, n)
+		}
+
+		w, err := unquote(w)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		words = append(words, w)
+	}
+
+	return words, scanner.Err()
+}
+
+// stripComment removes a YAML comment, which starts with "#" at the start of
+// the line or after a space.
+func stripComment(line string) string {
+	for i := 0; i < len(line); i++ {
+		if line[i] == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t') {
+			return line[:i]
+		}
+	}
+
+	return line
+}
+
+// unquote returns the plain or quoted YAML scalar s.
+func unquote(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		s = s[1 : len(s)-1]
+	}
+	if !isInitialismWord(s) {
+		return "", fmt.Errorf("%w: initialism %q", ErrInvalidOption, s)
+	}
+
+	return s, nil
+}
+
+// isInitialismWord reports whether w is made up of letters and digits, and
+// has at least one letter.
+func isInitialismWord(w string) bool {
+	if strings.IndexFunc(w, unicode.IsLetter) < 0 {
+		return false
+	}
+
+	return strings.IndexFunc(w, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) < 0
+}
+
+// AddInitialism adds the words to the initialisms of the instance. It is safe
+// to call concurrently with conversions.
+func (str *String) AddInitialism(words ...string) {
+	str.updateInitialisms(func(m map[string]string) {
+		for _, w := range words {
+			k, spelling := str.spellInitialism(w)
+			m[k] = spelling
+		}
+	})
+}
+
+// RemoveInitialism removes the words from the initialisms of the instance. It
+// is safe to call concurrently with conversions.
+func (str *String) RemoveInitialism(words ...string) {
+	str.updateInitialisms(func(m map[string]string) {
+		for _, w := range words {
+			delete(m, str.upper(w))
+		}
+	})
+}
+
+// updateInitialisms applies fn to a copy of the initialisms, and publishes
+// the copy once fn returns.
+func (str *String) updateInitialisms(fn func(map[string]string)) {
+	str.mu.Lock()
+	defer str.mu.Unlock()
+
+	old := str.initialisms.Load().spellings
+	m := make(map[string]string, len(old))
+	for k, v := range old {
+		m[k] = v
+	}
+	fn(m)
+
+	str.initialisms.Store(newInitialismSet(m))
+}
+
+// spellInitialism returns the uppercase key and the spelling of the
+// initialism w. Words without uppercase letters are uppercased, so "grpc" is
+// spelled "GRPC", while "IPv6" keeps its spelling.
+func (str *String) spellInitialism(w string) (key, spelling string) {
+	key = str.upper(w)
+	if strings.IndexFunc(w, unicode.IsUpper) < 0 {
+		return key, key
+	}
+
+	return key, w
+}
+
+var (
+	IsInitialism     = s.IsInitialism
+	Initialisms      = s.Initialisms
+	LooksLikeAcronym = s.LooksLikeAcronym
+)
+
+// IsInitialism reports whether the word is one of the initialisms of the
+// instance, ignoring case, so both "id" and "ID" are. The initialisms still
+// split words under WithoutInitialisms, but are not cased as initialisms.
+func (str *String) IsInitialism(word string) bool {
+	return str.isInitialism(str.upper(word))
+}
+
+// LooksLikeAcronym reports whether the word is probably an acronym, so
+// tooling can suggest initialisms that are not configured yet. Besides the
+// initialisms of the instance, it accepts words of 2 to 5 Latin letters and
+// digits that are all uppercase, e.g. "NASA", have no vowels, e.g. "sql" and
+// "html", or have a digit and at most 3 letters, e.g. "k8s" and "ec2", and
+// uppercase plurals, e.g. "APIs". Y is not a vowel here. The rules are
+// guesses, so "nth" and "by" look like acronyms too.
+func (str *String) LooksLikeAcronym(word string) bool {
+	if str.IsInitialism(word) {
+		return true
+	}
+	if len(word) >= 2 && len(word) <= 5 &&
+		strings.IndexFunc(word, func(r rune) bool { return r < 'A' || r > 'Z' }) < 0 {
+		return true
+	}
+	if stem, ok := strings.CutSuffix(word, "s"); ok && len(stem) >= 2 && len(stem) <= 5 &&
+		strings.IndexFunc(stem, func(r rune) bool { return r < 'A' || r > 'Z' }) < 0 {
+		return true
+	}
+	if len(word) < 2 || len(word) > 5 {
+		return false
+	}
+
+	var letters, digits, vowels int
+	for i, r := range strings.ToUpper(word) {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			letters++
+			if strings.ContainsRune("AEIOU", r) {
+				vowels++
+			}
+		case r >= '0' && r <= '9' && i > 0:
+			digits++
+		default:
+			return false
+		}
+	}
+
+	return vowels == 0 || digits > 0 && letters <= 3
+}
+
+// Initialisms returns the spellings of the initialisms of the instance in
+// sorted order, e.g. "API", "ID" and "IPv6".
+func (str *String) Initialisms() []string {
+	spellings := str.initialisms.Load().spellings
+
+	words := make([]string, 0, len(spellings))
+	for _, spelling := range spellings {
+		words = append(words, spelling)
+	}
+	sort.Strings(words)
+
+	return words
+}
+
+func (str *String) isInitialism(s string) bool {
+	return str.initialisms.Load().words[s]
+}
+
+// initialismSet is an immutable set of initialisms, together with the
+// shortest and longest rune length of its words, which bound the search in
+// extractCommonInitialism. Initialisms with digits or lowercase letters, such
+// as "EC2" and "IPv6", cannot be found that way, and are matched as whole
+// words instead, like special words.
+type initialismSet struct {
+	spellings map[string]string
+	words     map[string]bool
+	mixed     map[string]string
+	min, max  int
+}
+
+func newInitialismSet(spellings map[string]string) *initialismSet {
+	set := &initialismSet{
+		spellings: spellings,
+		words:     make(map[string]bool, len(spellings)),
+		mixed:     make(map[string]string),
+	}
+	for w, spelling := range spellings {
+		set.words[w] = true
+		if strings.IndexFunc(spelling, func(r rune) bool {
+			return unicode.IsNumber(r) || unicode.IsLower(r)
+		}) >= 0 {
+			set.mixed[w] = spelling
+		}
+
+		n := utf8.RuneCountInString(w)
+		if set.min == 0 || n < set.min {
+			set.min = n
+		}
+		if n > set.max {
+			set.max = n
+		}
+	}
+
+	return set
+}
+
+// longestPrefix returns the rune length of the longest initialism that the
+// uppercase runes start with, or zero.
+func (set *initialismSet) longestPrefix(runes []rune) int {
+	for n := min(len(runes), set.max); n > 0 && n >= set.min; n-- {
+		if set.words[string(runes[:n])] {
+			return n
+		}
+	}
+
+	return 0
+}
+
+// longestPrefixString is like longestPrefix for ASCII runs, whose byte and
+// rune lengths are the same.
+func (set *initialismSet) longestPrefixString(s string) int {
+	for n := min(len(s), set.max); n > 0 && n >= set.min; n-- {
+		if set.words[s[:n]] {
+			return n
+		}
+	}
+
+	return 0
+}
+
+// endsWithInitialismString is like endsWithInitialism for ASCII runs.
+func (set *initialismSet) endsWithInitialismString(s string) bool {
+	for len(s) > 0 {
+		n := set.longestPrefixString(s)
+		if n == 0 {
+			return false
+		}
+		s = s[n:]
+	}
+
+	return true
+}
+
+// endsWithInitialism reports whether the uppercase runes split into longest
+// initialisms from the start, ending with an initialism, e.g. "HTTPAPI".
+func (set *initialismSet) endsWithInitialism(runes []rune) bool {
+	for len(runes) > 0 {
+		n := set.longestPrefix(runes)
+		if n == 0 {
+			return false
+		}
+		runes = runes[n:]
+	}
+
+	return true
+}
+
+// matchLongest returns the byte length of the longest of the words that s
+// starts with, ignoring case. The word must not be followed by a lowercase
+// rune, so "githubClient" matches "GitHub", but "githubs" does not.
+func matchLongest(s string, words map[string]string) int {
+	var n int
+	for _, w := range words {
+		if len(w) <= n || len(w) > len(s) || !strings.EqualFold(s[:len(w)], w) {
+			continue
+		}
+
+		if r, _ := utf8.DecodeRuneInString(s[len(w):]); unicode.IsLower(r) {
+			continue
+		}
+
+		n = len(w)
+	}
+
+	return n
+}
@@ -0,0 +1,210 @@
+package stringcases_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestAddRemoveInitialism(t *testing.T) {
+	assert := assert.New(t)
+
+	str := stringcases.New(language.English)
+	assert.Equal("GrpcServer", str.ToPascal("grpc_server"))
+
+	str.AddInitialism("grpc")
+	assert.Equal("GRPCServer", str.ToPascal("grpc_server"))
+	assert.Equal("grpc_server", str.ToSnake("GRPCServer"))
+
+	str.RemoveInitialism("GRPC", "ID")
+	assert.Equal("GrpcServer", str.ToPascal("grpc_server"))
+	assert.Equal("UserId", str.ToPascal("user_id"))
+
+	assert.Equal("UserID", stringcases.ToPascal("user_id"))
+}
+
+func TestIsInitialism(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(stringcases.IsInitialism("ID"))
+	assert.True(stringcases.IsInitialism("id"))
+	assert.False(stringcases.IsInitialism("user"))
+	assert.False(stringcases.IsInitialism(""))
+
+	str := stringcases.New(language.English, stringcases.WithInitialisms(map[string]bool{
+		"API":  true,
+		"IPv6": true,
+	}))
+	assert.True(str.IsInitialism("ipv6"))
+	assert.False(str.IsInitialism("ID"))
+	assert.Equal([]string{"API", "IPv6"}, str.Initialisms())
+
+	str.AddInitialism("grpc")
+	assert.True(str.IsInitialism("GRPC"))
+	assert.Equal([]string{"API", "GRPC", "IPv6"}, str.Initialisms())
+
+	assert.Contains(stringcases.Initialisms(), "HTTP")
+}
+
+func TestLooksLikeAcronym(t *testing.T) {
+	tests := []struct {
+		word string
+		want bool
+	}{
+		{"ID", true},
+		{"sql", true},
+		{"HTML", true},
+		{"k8s", true},
+		{"ec2", true},
+		{"APIs", true},
+		{"NASA", true},
+		{"NATO", true},
+		{"xyz", true},
+		{"", false},
+		{"a", false},
+		{"of", false},
+		{"Nasa", false},
+		{"NASDAQ", false},
+		{"user", false},
+		{"user2", false},
+		{"2fa", false},
+		{"rhythm", false},
+		{"ß", false},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.want, stringcases.LooksLikeAcronym(tc.word), tc.word)
+	}
+
+	str := stringcases.New(language.English, stringcases.WithInitialisms(map[string]bool{"OAUTH": true}))
+	assert.True(t, str.LooksLikeAcronym("oauth"))
+	assert.False(t, str.LooksLikeAcronym("aura"))
+}
+
+func TestInitialismConcurrency(t *testing.T) {
+	str := stringcases.New(language.English)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				str.AddInitialism("GRPC")
+				str.RemoveInitialism("GRPC")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				assert.Contains(t, []string{"GRPCServer", "GrpcServer"}, str.ToPascal("grpc_server"))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLoadInitialisms(t *testing.T) {
+	tests := []struct {
+		scenario string
+		input    string
+	}{
+		{"json", `["grpc", "SKU"]`},
+		{"yaml flow", `[grpc, 'SKU']`},
+		{"yaml block", "---\n# shared acronyms\n- grpc\n- \"SKU\" # stock keeping unit\n\n"},
+		{"yaml indented block", "  - grpc\n  - 'SKU'\n"},
+		{"yaml multiline flow", "[\n  grpc, # remote calls\n  SKU,\n]"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert := assert.New(t)
+
+			m, err := stringcases.LoadInitialisms(strings.NewReader(test.input))
+			assert.NoError(err)
+			assert.Equal(map[string]bool{"GRPC": true, "SKU": true}, m)
+
+			str := stringcases.New(language.English, stringcases.WithInitialisms(m))
+			assert.Equal("GRPCServerSKU", str.ToPascal("grpc_server_sku"))
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, input := range []string{
+			"[grpc, sku",
+			"grpc: true",
+			"[grpc, [sku]]",
+			"[grpc, {sku: true}]",
+			"[grpc,, sku]",
+			`["grpc", ""]`,
+			`["a b"]`,
+			"[1, 2]",
+			"- grpc: true",
+			"- - grpc",
+			"-grpc",
+			"- &a grpc\n- *a",
+			"- \"grpc'",
+			"- grpc\n  - sku",
+			"- grpc\n---\n- sku",
+		} {
+			_, err := stringcases.LoadInitialisms(strings.NewReader(input))
+			assert.ErrorIs(t, err, stringcases.ErrInvalidOption, input)
+		}
+	})
+}
+
+func TestInitialismLength(t *testing.T) {
+	assert := assert.New(t)
+
+	str := stringcases.New(language.English, stringcases.WithExtraInitialisms("GRAPHQL", "OPENAPI"))
+
+	assert.Equal("graphql_server", str.ToSnake("GRAPHQLServer"))
+	assert.Equal("GRAPHQLServer", str.ToPascal("graphql_server"))
+	assert.Equal("load_openapi_spec", str.ToSnake("loadOPENAPISpec"))
+
+	str.RemoveInitialism("GRAPHQL", "OPENAPI")
+	assert.Equal("GraphqlServer", str.ToPascal("graphql_server"))
+}
+
+func TestDigitInitialisms(t *testing.T) {
+	str := stringcases.New(language.English, stringcases.WithExtraInitialisms("S3", "EC2", "IPv4", "IPv6", "UTF8"))
+
+	tests := []struct {
+		in, snake, camel, pascal string
+	}{
+		{"S3Bucket", "s3_bucket", "s3Bucket", "S3Bucket"},
+		{"EC2Instance", "ec2_instance", "ec2Instance", "EC2Instance"},
+		{"awsEC2InstanceID", "aws_ec2_instance_id", "awsEC2InstanceID", "AwsEC2InstanceID"},
+		{"ipv6_address", "ipv6_address", "ipv6Address", "IPv6Address"},
+		{"serverIPv4Addr", "server_ipv4_addr", "serverIPv4Addr", "ServerIPv4Addr"},
+		{"utf8_string", "utf8_string", "utf8String", "UTF8String"},
+		{"s3bucket", "s3bucket", "s3bucket", "S3bucket"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tc.snake, str.ToSnake(tc.in))
+			assert.Equal(tc.camel, str.ToCamel(tc.in))
+			assert.Equal(tc.pascal, str.ToPascal(tc.in))
+		})
+	}
+
+	t.Run("screaming snake", func(t *testing.T) {
+		assert.Equal(t, "SERVER_IPV6_ADDR", str.ToScreamingSnake("serverIPv6Addr"))
+	})
+
+	t.Run("load", func(t *testing.T) {
+		assert := assert.New(t)
+
+		m, err := stringcases.LoadInitialisms(strings.NewReader(`["ipv6", "IPv4", "EC2"]`))
+		assert.NoError(err)
+		assert.Equal(map[string]bool{"IPV6": true, "IPv4": true, "EC2": true}, m)
+
+		str := stringcases.New(language.English, stringcases.WithInitialisms(m))
+		assert.Equal("ServerIPv4EC2", str.ToPascal("server_ipv4_ec2"))
+	})
+}
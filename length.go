@@ -0,0 +1,99 @@
+package stringcases
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode/utf8"
+)
+
+// join joins the words with sep, shortening them with the length strategy
+// when the result would be longer than n runes. The hash suffix of src is
+// then appended as an extra word in the case wc.
+func (str *String) join(words []string, sep string, n int, src string, wc WordCase) string {
+	if str.maxLength <= 0 || length(words, sep) <= n {
+		return strings.Join(words, sep)
+	}
+
+	var hash string
+	if str.hashLength > 0 {
+		sum := sha256.Sum256([]byte(src))
+		hash = hex.EncodeToString(sum[:])[:min(str.hashLength, 2*len(sum))]
+		if wc == WordUpper {
+			hash = strings.ToUpper(hash)
+		}
+
+		// Keep room for the hash and its separator.
+		n -= len(hash) + utf8.RuneCountInString(sep)
+		if n <= 0 {
+			return hash
+		}
+	}
+
+	words = shorten(append([]string(nil), words...), sep, n, str.lengthStrategy)
+	if hash == "" {
+		return strings.Join(words, sep)
+	}
+
+	return strings.Join(append(words, hash), sep)
+}
+
+// shorten shortens the words with the strategy until they fit in n runes.
+func shorten(words []string, sep string, n int, strategy LengthStrategy) []string {
+	switch strategy {
+	case DropMiddleWords:
+		// The first and last words are kept, since they usually carry the
+		// most meaning, e.g. "user" and "id" in "user_account_settings_id".
+		for len(words) > 2 && length(words, sep) > n {
+			i := len(words) / 2
+			words = append(words[:i], words[i+1:]...)
+		}
+	case AbbreviateWords:
+		for i := len(words) - 1; i >= 0 && length(words, sep) > n; i-- {
+			words[i] = abbreviate(words[i])
+		}
+		for i := len(words) - 1; i >= 0 && length(words, sep) > n; i-- {
+			r, _ := utf8.DecodeRuneInString(words[i])
+			words[i] = string(r)
+		}
+	}
+
+	// Fall back to dropping the trailing words, and finally cut the first
+	// word.
+	for len(words) > 1 && length(words, sep) > n {
+		words = words[:len(words)-1]
+	}
+	if len(words) == 1 && utf8.RuneCountInString(words[0]) > n {
+		words[0] = string([]rune(words[0])[:max(n, 0)])
+	}
+
+	return words
+}
+
+// length returns the rune count of the words joined with sep.
+func length(words []string, sep string) int {
+	if len(words) == 0 {
+		return 0
+	}
+
+	n := (len(words) - 1) * utf8.RuneCountInString(sep)
+	for _, w := range words {
+		n += utf8.RuneCountInString(w)
+	}
+
+	return n
+}
+
+// abbreviate drops the vowels of the word after its first rune, e.g.
+// "configuration" becomes "cnfgrtn".
+func abbreviate(word string) string {
+	_, n := utf8.DecodeRuneInString(word)
+
+	return word[:n] + strings.Map(func(r rune) rune {
+		if strings.ContainsRune("aeiouAEIOU", r) {
+			return -1
+		}
+
+		return r
+	}, word[n:])
+}
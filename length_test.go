@@ -0,0 +1,121 @@
+package stringcases_test
+
+import (
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestWithMaxLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		strategy stringcases.LengthStrategy
+		in       string
+		want     string
+	}{
+		{"fits", 30, stringcases.TruncateWords, "userAccountSettings", "user_account_settings"},
+		{"truncate", 12, stringcases.TruncateWords, "userAccountSettings", "user_account"},
+		{"truncate first word", 3, stringcases.TruncateWords, "userAccountSettings", "use"},
+		{"drop middle", 16, stringcases.DropMiddleWords, "userAccountSettingsID", "user_account_id"},
+		{"drop middle to two words", 7, stringcases.DropMiddleWords, "userAccountSettingsID", "user_id"},
+		{"drop middle then truncate", 4, stringcases.DropMiddleWords, "userAccountSettingsID", "user"},
+		{"abbreviate", 17, stringcases.AbbreviateWords, "userAccountSettings", "user_accnt_sttngs"},
+		{"abbreviate to letters", 9, stringcases.AbbreviateWords, "userAccountSettings", "usr_a_s"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			str := stringcases.New(language.English, stringcases.WithMaxLength(tc.n, tc.strategy))
+			assert.Equal(t, tc.want, str.ToSnake(tc.in))
+		})
+	}
+
+	t.Run("pascal", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithMaxLength(11, stringcases.AbbreviateWords))
+		assert.Equal(t, "CnfgrtnNmbr", str.ToPascal("configuration number"))
+	})
+
+	t.Run("underscores", func(t *testing.T) {
+		str := stringcases.New(language.English,
+			stringcases.WithUnderscores(),
+			stringcases.WithMaxLength(10, stringcases.TruncateWords),
+		)
+		assert.Equal(t, "__user__", str.ToSnake("__userAccount__"))
+	})
+}
+
+func TestWithMaxWords(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		in   string
+		want string
+	}{
+		{"all", 0, "userAccountSettings", "userAccountSettings"},
+		{"fewer words", 5, "userAccountSettings", "userAccountSettings"},
+		{"first two", 2, "userAccountSettings", "userAccount"},
+		{"first", 1, "HTTPServerConfig", "http"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			str := stringcases.New(language.English, stringcases.WithMaxWords(tc.n))
+			assert.Equal(t, tc.want, str.ToCamel(tc.in))
+		})
+	}
+
+	t.Run("title", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithMaxWords(3))
+		assert.Equal(t, "The Lord Of", str.ToTitle("the lord of the rings"))
+		assert.Equal(t, "The Lord Of", string(str.AppendTitle(nil, "the lord of the rings")))
+	})
+
+	t.Run("with max length", func(t *testing.T) {
+		str := stringcases.New(language.English,
+			stringcases.WithMaxWords(3),
+			stringcases.WithMaxLength(17, stringcases.AbbreviateWords),
+		)
+		assert.Equal(t, "user_accnt_sttngs", str.ToSnake("user account settings page"))
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		str := stringcases.New(language.English,
+			stringcases.WithMaxWords(2),
+			stringcases.WithAmbiguityPolicy(stringcases.AmbiguityError),
+		)
+		got, err := str.Strict(str.ToKebab)("user account settings")
+		assert.NoError(t, err)
+		assert.Equal(t, "user-account", got)
+	})
+}
+
+func TestWithHashSuffix(t *testing.T) {
+	str := stringcases.New(language.English,
+		stringcases.WithMaxLength(19, stringcases.TruncateWords),
+		stringcases.WithHashSuffix(6),
+	)
+
+	t.Run("fits", func(t *testing.T) {
+		assert.Equal(t, "user_account", str.ToSnake("userAccount"))
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		assert := assert.New(t)
+
+		assert.Equal("user_account_169eaf", str.ToSnake("userAccountSettings"))
+		assert.Equal("user_account_518b10", str.ToSnake("userAccountSettingsID"))
+		assert.Equal("USER_ACCOUNT_169EAF", str.ToScreamingSnake("userAccountSettings"))
+		assert.Equal("userAccount518b10", str.ToCamel("userAccountSettingsID"))
+	})
+
+	t.Run("hash only", func(t *testing.T) {
+		str := stringcases.New(language.English,
+			stringcases.WithMaxLength(6, stringcases.TruncateWords),
+			stringcases.WithHashSuffix(6),
+		)
+		assert.Equal(t, "a984f5", str.ToSnake("user account settings"))
+	})
+}
@@ -0,0 +1,241 @@
+package stringcases
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+var (
+	ToDNSLabel = s.ToDNSLabel
+	ToSlug     = s.ToSlug
+	ToSlugN    = s.ToSlugN
+	ToFilename = s.ToFilename
+
+	ToDockerRepository = s.ToDockerRepository
+	ToDockerTag        = s.ToDockerTag
+	ToBucketName       = s.ToBucketName
+
+	AppendDNSLabel         = s.AppendDNSLabel
+	AppendSlug             = s.AppendSlug
+	AppendSlugN            = s.AppendSlugN
+	AppendFilename         = s.AppendFilename
+	AppendDockerRepository = s.AppendDockerRepository
+	AppendDockerTag        = s.AppendDockerTag
+	AppendBucketName       = s.AppendBucketName
+)
+
+// reservedFilenames are device names that cannot be used as file names on
+// Windows, with or without an extension.
+var reservedFilenames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true,
+	"COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true,
+	"LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// ToDNSLabel converts the string into an RFC 1123 DNS label, e.g.
+// "UserAPIService" becomes "user-api-service". Accented letters are
+// transliterated to ASCII like in ToSlug, other illegal characters are
+// stripped, and the label is cut to 63 characters. It returns ErrEmpty when
+// nothing is left.
+func (str *String) ToDNSLabel(s string) (string, error) {
+	label, err := str.AppendDNSLabel(nil, s)
+
+	return string(label), err
+}
+
+// AppendDNSLabel is like ToDNSLabel, but appends to dst, see AppendSnake. On
+// error dst is returned unchanged.
+func (str *String) AppendDNSLabel(dst []byte, s string) ([]byte, error) {
+	start := len(dst)
+	dst = truncate(squeeze(str.AppendKebab(dst, transliterate(s, asciiFold)), start, '-', isLowerAlnum), start, 63, '-')
+	if len(dst) == start {
+		return dst, ErrEmpty
+	}
+
+	return dst, nil
+}
+
+// ToSlug converts the string into a URL slug, e.g. "Café Menu" becomes
+// "cafe-menu". Accented letters are transliterated to ASCII, and anything
+// else outside [a-z0-9] is stripped.
+func (str *String) ToSlug(s string) string {
+	return string(str.AppendSlug(nil, s))
+}
+
+// AppendSlug is like ToSlug, but appends to dst, see AppendSnake.
+func (str *String) AppendSlug(dst []byte, s string) []byte {
+	return squeeze(str.AppendKebab(dst, transliterate(s, asciiFold)), len(dst), '-', isLowerAlnum)
+}
+
+// ToSlugN is like ToSlug, but limits the slug to at most n bytes. The slug is
+// cut at a word boundary unless the first word alone is longer than n. A
+// limit of zero or less means no limit.
+func (str *String) ToSlugN(s string, n int) string {
+	return string(str.AppendSlugN(nil, s, n))
+}
+
+// AppendSlugN is like ToSlugN, but appends to dst, see AppendSnake.
+func (str *String) AppendSlugN(dst []byte, s string, n int) []byte {
+	start := len(dst)
+	dst = str.AppendSlug(dst, s)
+	if slug := dst[start:]; n <= 0 || len(slug) <= n {
+		return dst
+	}
+
+	if i := bytes.LastIndexByte(dst[start:start+n+1], '-'); i > 0 {
+		return dst[:start+i]
+	}
+
+	return truncate(dst, start, n, '-')
+}
+
+// ToFilename converts the string into a file name that is safe on Linux,
+// macOS and Windows, joining words with sep (usually "_" or "-"), e.g.
+// ToFilename("Quarterly Report.PDF", "_") returns "quarterly_report.pdf".
+// The extension is kept, reserved names such as "CON" get an underscore
+// appended, and trailing dots and spaces are trimmed.
+func (str *String) ToFilename(s, sep string) string {
+	return string(str.AppendFilename(nil, s, sep))
+}
+
+// AppendFilename is like ToFilename, but appends to dst, see AppendSnake.
+func (str *String) AppendFilename(dst []byte, s, sep string) []byte {
+	stem, ext := s, ""
+	if i := strings.LastIndexByte(s, '.'); i > 0 {
+		stem, ext = s[:i], s[i+1:]
+	}
+
+	start := len(dst)
+	dst = filter(str.AppendDelimited(dst, stem, sep), start, func(r rune) bool {
+		return r >= ' ' && !strings.ContainsRune(`/\:*?"<>|`, r)
+	})
+	dst = dst[:start+len(bytes.TrimRight(dst[start:], ". "))]
+	if len(dst) == start {
+		return dst
+	}
+
+	if reservedFilenames[str.uppercase.String(string(dst[start:]))] {
+		dst = append(dst, '_')
+	}
+	if n := len(dst); ext != "" {
+		if dst = str.AppendDelimited(append(dst, '.'), ext, ""); len(dst) == n+1 {
+			dst = dst[:n]
+		}
+	}
+
+	return dst
+}
+
+// ToDockerRepository converts the string into a Docker image repository
+// name, e.g. "MyOrg/UserAPI Service" becomes "my-org/user-api-service". Each
+// slash-separated path component is kebab cased, transliterated and stripped
+// of illegal characters like in ToDNSLabel, and the name is cut to 255 characters. Separators left at
+// either end of a component, such as the "-" in "foo-/", are trimmed. It
+// returns ErrEmpty when nothing is left.
+func (str *String) ToDockerRepository(s string) (string, error) {
+	repo, err := str.AppendDockerRepository(nil, s)
+
+	return string(repo), err
+}
+
+// AppendDockerRepository is like ToDockerRepository, but appends to dst, see
+// AppendSnake. On error dst is returned unchanged.
+func (str *String) AppendDockerRepository(dst []byte, s string) ([]byte, error) {
+	start := len(dst)
+	for i, p := range strings.Split(s, "/") {
+		if i > 0 {
+			dst = append(dst, '/')
+		}
+		dst = squeeze(str.AppendKebab(dst, transliterate(p, asciiFold)), len(dst), '-', isLowerAlnum)
+	}
+
+	// The components only shrink, so they are moved down in place.
+	parts := bytes.Split(truncate(dst, start, 255, '/')[start:], []byte("/"))
+	dst = dst[:start]
+	for _, p := range parts {
+		if p = bytes.Trim(p, "-_."); len(p) > 0 {
+			if len(dst) > start {
+				dst = append(dst, '/')
+			}
+			dst = append(dst, p...)
+		}
+	}
+
+	if len(dst) == start {
+		return dst, ErrEmpty
+	}
+
+	return dst, nil
+}
+
+// ToDockerTag converts the string into a Docker image tag, e.g.
+// "feature/Add-Login" becomes "feature-add-login". Accented letters are
+// transliterated like in ToDNSLabel, and the tag is cut to 128 characters. It returns ErrEmpty when nothing is left.
+func (str *String) ToDockerTag(s string) (string, error) {
+	tag, err := str.AppendDockerTag(nil, s)
+
+	return string(tag), err
+}
+
+// AppendDockerTag is like ToDockerTag, but appends to dst, see AppendSnake.
+// On error dst is returned unchanged.
+func (str *String) AppendDockerTag(dst []byte, s string) ([]byte, error) {
+	start := len(dst)
+	dst = truncate(squeeze(str.AppendKebab(dst, transliterate(s, asciiFold)), start, '-', isLowerAlnum), start, 128, '-')
+	if len(dst) == start {
+		return dst, ErrEmpty
+	}
+
+	return dst, nil
+}
+
+// ToBucketName converts the string into an S3 or GCS bucket name, e.g.
+// "UserAPI Assets" becomes "user-api-assets". Accented letters are
+// transliterated like in ToDNSLabel, and the name is cut to 63 characters. Since dots and double hyphens are never emitted, the name
+// cannot look like an IP address or use the "xn--" prefix. It returns
+// ErrInvalidName when the result is shorter than 3 characters or uses a
+// reserved prefix or suffix.
+func (str *String) ToBucketName(s string) (string, error) {
+	name, err := str.AppendBucketName(nil, s)
+
+	return string(name), err
+}
+
+// AppendBucketName is like ToBucketName, but appends to dst, see
+// AppendSnake. On error dst is returned unchanged.
+func (str *String) AppendBucketName(dst []byte, s string) ([]byte, error) {
+	start := len(dst)
+	dst = truncate(squeeze(str.AppendKebab(dst, transliterate(s, asciiFold)), start, '-', isLowerAlnum), start, 63, '-')
+
+	name := string(dst[start:])
+	switch {
+	case len(name) < 3:
+		return dst[:start], fmt.Errorf("%w: bucket name %q is shorter than 3 characters", ErrInvalidName, name)
+	case strings.HasPrefix(name, "sthree-"), strings.HasPrefix(name, "amzn-s3-demo-"):
+		return dst[:start], fmt.Errorf("%w: bucket name %q has a reserved prefix", ErrInvalidName, name)
+	case strings.HasSuffix(name, "-s3alias"):
+		return dst[:start], fmt.Errorf("%w: bucket name %q has a reserved suffix", ErrInvalidName, name)
+	}
+
+	return dst, nil
+}
+
+// truncate cuts the ASCII name dst[start:] to at most n bytes, and trims any
+// trailing sep left behind.
+func truncate(dst []byte, start, n int, sep byte) []byte {
+	if len(dst)-start > n {
+		dst = dst[:start+n]
+	}
+	for len(dst) > start && dst[len(dst)-1] == sep {
+		dst = dst[:len(dst)-1]
+	}
+
+	return dst
+}
+
+func isLowerAlnum(r rune) bool {
+	return 'a' <= r && r <= 'z' || '0' <= r && r <= '9'
+}
@@ -0,0 +1,204 @@
+package stringcases_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToDNSLabel(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		want     string
+	}{
+		{"pascal", "UserAPIService", "user-api-service"},
+		{"snake", "_payment_gateway_", "payment-gateway"},
+		{"accents", "café menu", "cafe-menu"},
+		{"non-ascii", "naïve Ünïcödé", "naive-unicode"},
+		{"illegal runes", "user@api", "user-api"},
+		{"too long", strings.Repeat("ab-", 30), strings.TrimSuffix(strings.Repeat("ab-", 21), "-")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert := assert.New(t)
+
+			got, err := stringcases.ToDNSLabel(test.text)
+			assert.NoError(err)
+			assert.Equal(test.want, got)
+			assert.LessOrEqual(len(got), 63)
+		})
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := stringcases.ToDNSLabel("日本")
+		assert.ErrorIs(t, err, stringcases.ErrEmpty)
+	})
+}
+
+func TestToSlug(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		want     string
+	}{
+		{"accents", "Café Menu", "cafe-menu"},
+		{"decomposed", "Cafe\u0301 Menu", "cafe-menu"},
+		{"folding", "Straße Ærø", "strasse-aero"},
+		{"punctuation", "Hello, World! -- 2024", "hello-world-2024"},
+		{"camel", "userAPIGuide", "user-api-guide"},
+		{"non latin", "日本 Guide", "guide"},
+		{"empty", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToSlug(test.text))
+		})
+	}
+}
+
+func TestToSlugN(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		n        int
+		want     string
+	}{
+		{"fits", "Café Menu", 20, "cafe-menu"},
+		{"exact", "Café Menu", 9, "cafe-menu"},
+		{"word boundary", "The Quick Brown Fox", 12, "the-quick"},
+		{"boundary at limit", "The Quick Brown Fox", 9, "the-quick"},
+		{"long word", "Supercalifragilistic", 5, "super"},
+		{"no limit", "Café Menu", 0, "cafe-menu"},
+		{"negative", "abc def", -1, "abc-def"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToSlugN(test.text, test.n))
+		})
+	}
+}
+
+func TestToFilename(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		sep      string
+		want     string
+	}{
+		{"snake", "Quarterly Report.PDF", "_", "quarterly_report.pdf"},
+		{"kebab", "Quarterly Report.PDF", "-", "quarterly-report.pdf"},
+		{"illegal runes", `a/b\c:d*e?f"g<h>i|j`, "-", "a-b-c-d-e-f-g-h-i-j"},
+		{"illegal separator", "hello world", "/", "helloworld"},
+		{"reserved", "con", "_", "con_"},
+		{"reserved with extension", "Aux.txt", "_", "aux_.txt"},
+		{"hidden file", ".bashrc", "_", "bashrc"},
+		{"trailing dots", "notes...", "_", "notes"},
+		{"no extension", "userAPI", "_", "user_api"},
+		{"empty", "", "_", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToFilename(test.text, test.sep))
+		})
+	}
+}
+
+func TestToDocker(t *testing.T) {
+	t.Run("repository", func(t *testing.T) {
+		tests := []struct {
+			scenario string
+			text     string
+			want     string
+		}{
+			{"pascal", "UserAPIService", "user-api-service"},
+			{"path", "MyOrg/UserAPI Service", "my-org/user-api-service"},
+			{"empty components", "/myOrg//app/", "my-org/app"},
+			{"illegal runes", "café@app", "cafe-app"},
+			{"non-ascii", "Ünïcödé/naïve", "unicode/naive"},
+			{"trailing separator", "foo-/", "foo"},
+			{"separators around slash", "foo_/.bar-", "foo/bar"},
+			{"cut at separator", strings.Repeat("a", 251) + "/bc-de", strings.Repeat("a", 251) + "/bc"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.scenario, func(t *testing.T) {
+				assert := assert.New(t)
+
+				got, err := stringcases.ToDockerRepository(test.text)
+				assert.NoError(err)
+				assert.Equal(test.want, got)
+			})
+		}
+
+		_, err := stringcases.ToDockerRepository("//")
+		assert.ErrorIs(t, err, stringcases.ErrEmpty)
+	})
+
+	t.Run("tag", func(t *testing.T) {
+		tests := []struct {
+			scenario string
+			text     string
+			want     string
+		}{
+			{"branch", "feature/Add-Login", "feature-add-login"},
+			{"version", "release_v1.2.3", "release-v1-2-3"},
+			{"non-ascii", "naïve-fix", "naive-fix"},
+			{"too long", strings.Repeat("a", 200), strings.Repeat("a", 128)},
+		}
+
+		for _, test := range tests {
+			t.Run(test.scenario, func(t *testing.T) {
+				assert := assert.New(t)
+
+				got, err := stringcases.ToDockerTag(test.text)
+				assert.NoError(err)
+				assert.Equal(test.want, got)
+			})
+		}
+
+		_, err := stringcases.ToDockerTag("...")
+		assert.ErrorIs(t, err, stringcases.ErrEmpty)
+	})
+}
+
+func TestToBucketName(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		want     string
+	}{
+		{"words", "UserAPI Assets", "user-api-assets"},
+		{"ip like", "192.168.5.4", "192-168-5-4"},
+		{"minimum length", "abc", "abc"},
+		{"non-ascii", "naïve Assets", "naive-assets"},
+		{"too long", strings.Repeat("bucket", 20), strings.Repeat("bucket", 20)[:63]},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert := assert.New(t)
+
+			got, err := stringcases.ToBucketName(test.text)
+			assert.NoError(err)
+			assert.Equal(test.want, got)
+		})
+	}
+
+	for _, text := range []string{"", "ab", "--a--", "sthree-logs", "logs-s3alias"} {
+		t.Run(text, func(t *testing.T) {
+			_, err := stringcases.ToBucketName(text)
+			assert.ErrorIs(t, err, stringcases.ErrInvalidName)
+		})
+	}
+}
+
+func TestToSlugSeparators(t *testing.T) {
+	assert.Equal(t, "hello-world", stringcases.ToSlug("Hello—World"))
+}
@@ -0,0 +1,752 @@
+package stringcases
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Option configures a String created by New.
+type Option func(*String)
+
+// Validate reports options that are out of range or contradict each other,
+// which would otherwise produce surprising output. The errors wrap
+// ErrInvalidOption, ErrInvalidSeparator, ErrConflictingNumberPolicy or
+// ErrConflictingOptions.
+func (str *String) Validate() error {
+	var errs []error
+	report := func(err error, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%w: %s", err, fmt.Sprintf(format, args...)))
+	}
+
+	if str.numbers < NumberDefault || str.numbers > NumberUnits {
+		report(ErrInvalidOption, "number policy %d", str.numbers)
+	}
+	if str.symbols < SymbolDrop || str.symbols > SymbolError {
+		report(ErrInvalidOption, "symbol policy %d", str.symbols)
+	}
+	if str.cjk < CJKDrop || str.cjk > CJKSegment {
+		report(ErrInvalidOption, "CJK policy %d", str.cjk)
+	}
+	if str.emoji < EmojiSymbol || str.emoji > EmojiSeparator {
+		report(ErrInvalidOption, "emoji policy %d", str.emoji)
+	}
+	if str.sharpS < SharpSExpand || str.sharpS > SharpSCapital {
+		report(ErrInvalidOption, "sharp s policy %d", str.sharpS)
+	}
+	if str.invalidUTF8 < UTF8Error || str.invalidUTF8 > UTF8Drop {
+		report(ErrInvalidOption, "UTF-8 policy %d", str.invalidUTF8)
+	}
+	if str.whitespace < WhitespaceSeparate || str.whitespace > WhitespaceIgnore {
+		report(ErrInvalidOption, "whitespace policy %d", str.whitespace)
+	}
+	if str.acronymBoundary < AcronymBoundaryGreedy || str.acronymBoundary > AcronymBoundaryLastUpper {
+		report(ErrInvalidOption, "acronym boundary policy %d", str.acronymBoundary)
+	}
+	if str.leading < LeadingLower || str.leading > LeadingError {
+		report(ErrInvalidOption, "leading initialism policy %d", str.leading)
+	}
+	if str.ambiguity < AmbiguityIgnore || str.ambiguity > AmbiguityError {
+		report(ErrInvalidOption, "ambiguity policy %d", str.ambiguity)
+	}
+	if str.contractions < ContractionIgnore || str.contractions > ContractionExpand {
+		report(ErrInvalidOption, "contraction policy %d", str.contractions)
+	}
+	if str.lengthStrategy < TruncateWords || str.lengthStrategy > AbbreviateWords {
+		report(ErrInvalidOption, "length strategy %d", str.lengthStrategy)
+	}
+	if str.maxLength < 0 {
+		report(ErrInvalidOption, "max length %d", str.maxLength)
+	}
+	if str.maxWords < 0 {
+		report(ErrInvalidOption, "max words %d", str.maxWords)
+	}
+	if str.hashLength < 0 || str.hashLength > 2*sha256.Size {
+		report(ErrInvalidOption, "hash suffix length %d", str.hashLength)
+	}
+	if str.symbols == SymbolReplace && str.placeholder == "" {
+		report(ErrInvalidOption, "empty symbol placeholder")
+	}
+
+	if str.ambiguity == AmbiguityMark {
+		// A marker that is empty or part of a word would not mark anything.
+		if str.marker == "" || strings.IndexFunc(str.marker, func(r rune) bool {
+			return unicode.IsLetter(r) || unicode.IsNumber(r)
+		}) >= 0 {
+			report(ErrInvalidSeparator, "ambiguity marker %q", str.marker)
+		}
+	}
+
+	if str.numbers == NumberUnits && str.tokenizer != nil {
+		report(ErrConflictingNumberPolicy, "units are not matched by custom tokenizers")
+	}
+
+	if str.hashLength > 0 && str.maxLength == 0 {
+		report(ErrConflictingOptions, "hash suffix without max length")
+	}
+	if str.hashLength > 0 && str.maxLength > 0 && str.hashLength > str.maxLength {
+		report(ErrConflictingOptions, "hash suffix length %d exceeds max length %d", str.hashLength, str.maxLength)
+	}
+	if str.ignoreInitialisms && str.acronyms {
+		report(ErrConflictingOptions, "uppercase acronyms without initialisms")
+	}
+	if str.ignoreInitialisms && str.leading != LeadingLower {
+		report(ErrConflictingOptions, "leading initialism policy without initialisms")
+	}
+	if str.tokenizer != nil && str.versions {
+		report(ErrConflictingOptions, "version tokens are not matched by custom tokenizers")
+	}
+	if str.tokenizer != nil && str.patterns {
+		report(ErrConflictingOptions, "numeric patterns are not matched by custom tokenizers")
+	}
+	if str.tokenizer != nil && str.graphemes {
+		report(ErrConflictingOptions, "grapheme clusters are not matched by custom tokenizers")
+	}
+
+	return errors.Join(errs...)
+}
+
+// WithMinorWords replaces the words that ToTitle keeps lowercase when they
+// are neither the first nor the last word.
+func WithMinorWords(words ...string) Option {
+	return func(str *String) {
+		str.SetMinorWords(words...)
+	}
+}
+
+// WithInitialisms replaces the common initialisms with the given set. Keys
+// without uppercase letters are uppercased, and only entries set to true are
+// kept. Initialisms may contain digits and lowercase letters, such as "EC2"
+// and "IPv6", which keep their spelling.
+func WithInitialisms(initialisms map[string]bool) Option {
+	return func(str *String) {
+		m := make(map[string]string, len(initialisms))
+		for w, ok := range initialisms {
+			if ok {
+				k, spelling := str.spellInitialism(w)
+				m[k] = spelling
+			}
+		}
+
+		str.initialisms.Store(newInitialismSet(m))
+	}
+}
+
+// WithExtraInitialisms adds the words to the initialisms, e.g.
+// WithExtraInitialisms("GRPC", "SKU") keeps the defaults and adds two more.
+// With WithExtraInitialisms("S3", "EC2"), "EC2Instance" becomes
+// "ec2_instance" in snake case instead of "ec_2_instance".
+func WithExtraInitialisms(words ...string) Option {
+	return func(str *String) {
+		str.AddInitialism(words...)
+	}
+}
+
+// AcronymBoundaryPolicy controls where a run of uppercase letters that is
+// directly followed by lowercase letters splits, such as "APIkey" and
+// "IDcard".
+type AcronymBoundaryPolicy int
+
+const (
+	// AcronymBoundaryGreedy keeps known initialisms whole, e.g. "APIkey"
+	// splits into "API" and "key", and "IDcard" into "ID" and "card". An
+	// initialism that leaves the last uppercase letter to start the next
+	// word is preferred, so "HTTPServer" splits into "HTTP" and "Server".
+	// Other runs are kept whole, e.g. "ABCdef" splits into "ABC" and "def".
+	AcronymBoundaryGreedy AcronymBoundaryPolicy = iota
+
+	// AcronymBoundaryLastUpper always lets the last uppercase letter start
+	// the next word, e.g. "IDcard" splits into "I" and "Dcard".
+	AcronymBoundaryLastUpper
+)
+
+// WithAcronymBoundary sets where a run of uppercase letters followed by
+// lowercase letters splits.
+func WithAcronymBoundary(p AcronymBoundaryPolicy) Option {
+	return func(str *String) {
+		str.acronymBoundary = p
+	}
+}
+
+// WithUppercaseAcronyms treats every run of two or more uppercase letters as
+// an initialism, even when it is not in the list, e.g. "NASAProgram" becomes
+// "NASAProgram" in Pascal case instead of "NasaProgram", and still
+// "nasa_program" in snake case. The last uppercase letter of an unknown run
+// that is followed by lowercase letters starts the next word, like "P" in
+// "NASAProgram". Input in all caps, such as "USER_NAME", keeps its caps too.
+func WithUppercaseAcronyms() Option {
+	return func(str *String) {
+		str.acronyms = true
+	}
+}
+
+// WithMinimalChanges keeps the words that are already in the case of the
+// target convention as they are, and only recases the others, e.g.
+// "UserApiKey" stays "UserApiKey" in Pascal case instead of becoming
+// "UserAPIKey", while "user_api_key" still becomes "UserAPIKey". This keeps
+// diffs small when converting existing names in bulk.
+func WithMinimalChanges() Option {
+	return func(str *String) {
+		str.minimal = true
+	}
+}
+
+// WithoutInitialisms disables uppercasing of initialisms, so ToPascal turns
+// "userId" into "UserId". Initialisms are still used to split words, e.g.
+// "userAPIKey" still becomes "UserApiKey".
+func WithoutInitialisms() Option {
+	return func(str *String) {
+		str.ignoreInitialisms = true
+	}
+}
+
+// WithSpecialWords adds mixed case words, such as "OAuth", "gRPC" or
+// "GitHub", that are kept as a single word and keep their exact casing
+// wherever initialisms are uppercased. Title cased words still start
+// uppercase, so "iOS" becomes "IOS" in Pascal case, and snake and kebab case
+// still lowercase them, e.g. "GitHubOAuthApp" becomes "github_oauth_app".
+func WithSpecialWords(words ...string) Option {
+	return func(str *String) {
+		m := make(map[string]string, len(str.specialWords)+len(words))
+		for k, v := range str.specialWords {
+			m[k] = v
+		}
+		for _, w := range words {
+			m[strings.ToLower(w)] = w
+		}
+
+		str.specialWords = m
+	}
+}
+
+// NumberPolicy controls how numbers are split from the letters around them.
+type NumberPolicy int
+
+const (
+	// NumberDefault glues numbers to the word before them, except after a
+	// run of uppercase letters, e.g. "i18n" and "userV2", but "net-http-2".
+	NumberDefault NumberPolicy = iota
+
+	// NumberSplit makes every run of numbers its own word, e.g. "i-18-n".
+	NumberSplit
+
+	// NumberGlue always glues numbers to the word before them, e.g.
+	// "net-http2".
+	NumberGlue
+
+	// NumberGlueInitialism glues numbers only to initialisms, e.g.
+	// "net-http2" but "user-2fa".
+	NumberGlueInitialism
+
+	// NumberUnits keeps numbers together with a unit or ordinal suffix after
+	// them, and splits them from the word before, e.g. "max10MBUpload"
+	// becomes "max-10mb-upload" and "retry3rdAttempt" becomes
+	// "retry-3rd-attempt". Other numbers are split like NumberDefault.
+	NumberUnits
+)
+
+// WithNumberPolicy sets how numbers are split from the letters around them.
+func WithNumberPolicy(p NumberPolicy) Option {
+	return func(str *String) {
+		str.numbers = p
+	}
+}
+
+// WithVersionTokens keeps versions such as "v2", "V10" and "v1beta1" as
+// single words regardless of the number policy, so "apiV1Beta1" becomes
+// "api_v1beta1" and "APIV1beta1".
+func WithVersionTokens() Option {
+	return func(str *String) {
+		str.versions = true
+	}
+}
+
+// WithNumericPatterns keeps UUIDs, hexadecimal literals and dates as single
+// words that are never recased, e.g. "backup20240101Final" becomes
+// "backup_20240101_final" and "color0xFF" becomes "color_0xFF" in snake
+// case. Dates are eight digits, YYYYMMDD, or fourteen with the time of day.
+func WithNumericPatterns() Option {
+	return func(str *String) {
+		str.patterns = true
+	}
+}
+
+// WithRomanNumerals keeps uppercase Roman numerals uppercase like
+// initialisms, e.g. "HenryVIIIPortrait" stays "HenryVIIIPortrait" in Pascal
+// case instead of becoming "HenryViiiPortrait". A numeral of two or more
+// letters ends before a capitalised word, like "VIII" in "VIIIPortrait".
+// Numerals are only recognized in input that has lowercase letters, so
+// "MIX_VALUE" still becomes "MixValue".
+func WithRomanNumerals() Option {
+	return func(str *String) {
+		str.romans = true
+	}
+}
+
+// WithGraphemeClusters keeps grapheme clusters whole, so words are never
+// split between a rune and the combining marks that follow it, e.g.
+// "cafe\u0301s" stays one word. Emoji joined with the zero-width joiner,
+// such as "👩\u200d💻", and flags such as "🇯🇵" are single emoji, see
+// EmojiToken, and clusters that are not part of a word are skipped whole.
+// The clusters follow a simplified form of the rules of Unicode Standard
+// Annex #29.
+func WithGraphemeClusters() Option {
+	return func(str *String) {
+		str.graphemes = true
+	}
+}
+
+// WithDelimiterRuns preserves runs of separators between words instead of
+// collapsing them, so "foo__bar" stays "foo__bar" in snake case and becomes
+// "foo--bar" in kebab case. Each extra separator is an empty word.
+func WithDelimiterRuns() Option {
+	return func(str *String) {
+		str.delimiters = true
+	}
+}
+
+// WithUnderscores keeps leading and trailing underscores as they are, so
+// "_private" becomes "_Private" in Pascal case, and "__init__" stays
+// "__init__" in every case.
+func WithUnderscores() Option {
+	return func(str *String) {
+		str.underscores = true
+	}
+}
+
+// WithDisallowedRunes makes Strict reject input containing a rune for which
+// fn returns true, e.g. WithDisallowedRunes(unicode.IsPunct).
+func WithDisallowedRunes(fn func(rune) bool) Option {
+	return func(str *String) {
+		str.disallowed = fn
+	}
+}
+
+// WithSeparators sets the runes that separate words. Every other rune is part
+// of a word, and caseless runes such as "." behave like lowercase letters,
+// e.g. with WithSeparators(unicode.IsSpace), "main.go file" becomes
+// "main.go_file" in snake case.
+func WithSeparators(fn func(rune) bool) Option {
+	return func(str *String) {
+		str.separators = fn
+	}
+}
+
+// SymbolPolicy controls what happens to punctuation and symbols other than
+// whitespace, "_" and "-", such as "'" and "@".
+type SymbolPolicy int
+
+const (
+	// SymbolDrop drops symbols, so they separate words.
+	SymbolDrop SymbolPolicy = iota
+
+	// SymbolReplace replaces every run of symbols with a placeholder word,
+	// see WithSymbolPlaceholder.
+	SymbolReplace
+
+	// SymbolKeep keeps symbols verbatim inside words, e.g.
+	// "user's-email@domain" becomes "user's_email@domain" in snake case.
+	SymbolKeep
+
+	// SymbolError makes Strict reject symbols with ErrDisallowedRune. Other
+	// converters drop them.
+	SymbolError
+)
+
+// WithSymbolPolicy sets what happens to punctuation and symbols.
+func WithSymbolPolicy(p SymbolPolicy) Option {
+	return func(str *String) {
+		str.symbols = p
+	}
+}
+
+// WithSymbolPlaceholder replaces every run of punctuation and symbols with
+// the placeholder word, e.g. with WithSymbolPlaceholder("at"),
+// "user@domain" becomes "user_at_domain" in snake case.
+func WithSymbolPlaceholder(placeholder string) Option {
+	return func(str *String) {
+		str.symbols = SymbolReplace
+		str.placeholder = placeholder
+	}
+}
+
+// WithNormalization normalizes the input to the Unicode form before it is
+// tokenized, so decomposed input such as "cafe\u0301" tokenizes the same as
+// "café" with norm.NFC.
+func WithNormalization(f norm.Form) Option {
+	return func(str *String) {
+		str.normalize = true
+		str.form = f
+	}
+}
+
+// WithStripDiacritics removes diacritics before the input is tokenized, e.g.
+// "Śledź już" becomes "sledz-juz" in kebab case.
+func WithStripDiacritics() Option {
+	return func(str *String) {
+		str.diacritics = true
+	}
+}
+
+// WithTransliteration converts the input to ASCII before it is tokenized.
+// The runes in table are replaced, diacritics are stripped from the rest,
+// and any other non-ASCII rune separates words. The table extends a default
+// table, e.g. "Straße Ærø" becomes "strasse_aero" in snake case.
+func WithTransliteration(table map[rune]string) Option {
+	return func(str *String) {
+		m := make(map[rune]string, len(asciiFold)+len(table))
+		for r, s := range asciiFold {
+			m[r] = s
+		}
+		for r, s := range table {
+			m[r] = s
+		}
+
+		str.transliteration = m
+	}
+}
+
+// CJKPolicy controls what happens to Chinese, Japanese and Korean runes,
+// which have no case.
+type CJKPolicy int
+
+const (
+	// CJKDrop drops CJK runes, so they separate words.
+	CJKDrop CJKPolicy = iota
+
+	// CJKPassthrough keeps every run of CJK runes as a single word, e.g.
+	// "用户API" becomes "用户_api" in snake case.
+	CJKPassthrough
+
+	// CJKSegment splits runs of CJK runes where the script changes, e.g.
+	// "日本語テキスト" becomes "日本語_テキスト" in snake case. There is no
+	// dictionary based segmentation.
+	CJKSegment
+)
+
+// WithCJKPolicy sets what happens to CJK runes.
+func WithCJKPolicy(p CJKPolicy) Option {
+	return func(str *String) {
+		str.cjk = p
+	}
+}
+
+// EmojiPolicy controls what happens to emoji.
+type EmojiPolicy int
+
+const (
+	// EmojiSymbol handles emoji like other symbols, see SymbolPolicy, so they
+	// are dropped and separate words by default.
+	EmojiSymbol EmojiPolicy = iota
+
+	// EmojiToken keeps every emoji as a word of its own, together with its
+	// variation selector and skin tone modifier, e.g. "I❤️Go" becomes
+	// "i_❤️_go" in snake case.
+	EmojiToken
+
+	// EmojiSeparator drops emoji so they separate words, even when other
+	// symbols are kept or replaced by the SymbolPolicy.
+	EmojiSeparator
+)
+
+// WithEmojiPolicy sets what happens to emoji.
+func WithEmojiPolicy(p EmojiPolicy) Option {
+	return func(str *String) {
+		str.emoji = p
+	}
+}
+
+// WithASCIICase cases words with language-neutral rules instead of the rules
+// of the language tag, so ASCII letters always fold to ASCII. For Turkish,
+// "ID" then lowercases to "id" instead of "ıd", which suits identifiers.
+func WithASCIICase() Option {
+	return func(str *String) {
+		str.asciiCase = true
+		str.titlecase = cases.Title(language.Und)
+		str.lowercase = cases.Lower(language.Und)
+		str.uppercase = cases.Upper(language.Und)
+	}
+}
+
+// SharpSPolicy controls how "ß" is uppercased.
+type SharpSPolicy int
+
+const (
+	// SharpSExpand uppercases "ß" to "SS", e.g. "straße" becomes "STRASSE".
+	// The result is longer and does not lowercase back to "ß".
+	SharpSExpand SharpSPolicy = iota
+
+	// SharpSCapital uppercases "ß" to the capital "ẞ", e.g. "straße" becomes
+	// "STRAẞE", which keeps the length and lowercases back to "straße".
+	SharpSCapital
+)
+
+// WithSharpSPolicy sets how "ß" is uppercased.
+func WithSharpSPolicy(p SharpSPolicy) Option {
+	return func(str *String) {
+		str.sharpS = p
+	}
+}
+
+// UTF8Policy controls what happens to invalid UTF-8 in the input.
+type UTF8Policy int
+
+const (
+	// UTF8Error makes Strict reject invalid UTF-8 with ErrInvalidUTF8. Other
+	// converters replace it like UTF8Replace.
+	UTF8Error UTF8Policy = iota
+
+	// UTF8Replace replaces every run of invalid bytes with U+FFFD, which is a
+	// symbol, so it separates words under the default SymbolPolicy, e.g.
+	// "user\xffName" becomes "user_name" in snake case.
+	UTF8Replace
+
+	// UTF8Drop removes invalid bytes, e.g. "us\xffer" becomes "user".
+	UTF8Drop
+)
+
+// WithUTF8Policy sets what happens to invalid UTF-8 in the input.
+func WithUTF8Policy(p UTF8Policy) Option {
+	return func(str *String) {
+		str.invalidUTF8 = p
+	}
+}
+
+// WhitespacePolicy controls what happens to Unicode whitespace other than
+// the ASCII space, tab and line breaks, such as the no-break space U+00A0
+// and the ideographic space U+3000.
+type WhitespacePolicy int
+
+const (
+	// WhitespaceSeparate makes every Unicode space separate words, like the
+	// ASCII space, e.g. "user\u00a0name" becomes "user_name" in snake case.
+	WhitespaceSeparate WhitespacePolicy = iota
+
+	// WhitespaceIgnore removes non-ASCII spaces before the input is
+	// tokenized, so they do not split words, e.g. "1\u202f000" becomes
+	// "1000".
+	WhitespaceIgnore
+)
+
+// WithWhitespacePolicy sets what happens to non-ASCII whitespace.
+func WithWhitespacePolicy(p WhitespacePolicy) Option {
+	return func(str *String) {
+		str.whitespace = p
+	}
+}
+
+// hungarianPrefixes is the default list of prefixes removed by
+// WithStripHungarian.
+var hungarianPrefixes = []string{"m_", "str", "b", "n", "p"}
+
+// WithStripHungarian removes Hungarian notation prefixes from the start of the
+// input before it is tokenized, e.g. "m_strUserName" becomes "user_name" in
+// snake case. Prefixes ending with "_" are always removed, and others only
+// when an uppercase letter follows, so "bTree" loses its "b" but "bucket"
+// does not. Without prefixes, "m_", "str", "b", "n" and "p" are removed.
+func WithStripHungarian(prefixes ...string) Option {
+	return func(str *String) {
+		if len(prefixes) == 0 {
+			prefixes = hungarianPrefixes
+		}
+
+		p := append([]string(nil), prefixes...)
+		sort.SliceStable(p, func(i, j int) bool {
+			return len(p[i]) > len(p[j])
+		})
+
+		str.hungarian = p
+	}
+}
+
+// WithTrimPrefixes removes the first of the prefixes that the input starts
+// with before it is tokenized, ignoring case, e.g. with
+// WithTrimPrefixes("tbl_"), "tbl_user_accounts" becomes "UserAccounts" in
+// Pascal case.
+func WithTrimPrefixes(prefixes ...string) Option {
+	return func(str *String) {
+		str.trimPrefixes = append(str.trimPrefixes, prefixes...)
+	}
+}
+
+// WithTrimSuffixes removes the first of the suffixes that the input ends with
+// before it is tokenized, ignoring case, e.g. with WithTrimSuffixes("_id"),
+// "author_id" becomes "author".
+func WithTrimSuffixes(suffixes ...string) Option {
+	return func(str *String) {
+		str.trimSuffixes = append(str.trimSuffixes, suffixes...)
+	}
+}
+
+// WithAbbreviations replaces words with their abbreviations, ignoring case,
+// e.g. with WithAbbreviations(map[string]string{"configuration": "cfg",
+// "number": "num"}), "ConfigurationNumber" becomes "cfg_num" in snake case.
+// The abbreviations are cased like the words they replace.
+func WithAbbreviations(abbreviations map[string]string) Option {
+	return func(str *String) {
+		m := make(map[string]string, len(str.abbreviations)+len(abbreviations))
+		for k, v := range str.abbreviations {
+			m[k] = v
+		}
+		for k, v := range abbreviations {
+			m[strings.ToLower(k)] = v
+		}
+
+		str.abbreviations = m
+	}
+}
+
+// WithExpansions replaces abbreviated words with their expansions, ignoring
+// case, e.g. with WithExpansions(map[string]string{"cfg": "configuration",
+// "srv": "server", "addr": "address"}), "cfg_srv_addr" becomes
+// "ConfigurationServerAddress" in Pascal case. Expansions separated by spaces
+// become several words, so "tz" can expand to "time zone".
+func WithExpansions(expansions map[string]string) Option {
+	return func(str *String) {
+		m := make(map[string]string, len(str.expansions)+len(expansions))
+		for k, v := range str.expansions {
+			m[k] = v
+		}
+		for k, v := range expansions {
+			m[strings.ToLower(k)] = v
+		}
+
+		str.expansions = m
+	}
+}
+
+// LengthStrategy controls how WithMaxLength shortens words that are too long.
+type LengthStrategy int
+
+const (
+	// TruncateWords drops the trailing words, e.g. "user_account_settings"
+	// becomes "user_account" at 12 runes.
+	TruncateWords LengthStrategy = iota
+
+	// DropMiddleWords keeps the first and last words and drops the words
+	// between them, e.g. "user_account_settings_id" becomes
+	// "user_account_id" at 16 runes.
+	DropMiddleWords
+
+	// AbbreviateWords drops the vowels of the words, starting from the last
+	// one, and then shortens them to their first letter, e.g.
+	// "user_account_settings" becomes "user_accnt_sttngs" at 17 runes.
+	AbbreviateWords
+)
+
+// WithMaxLength limits the output of the converters built on Format to n
+// runes, and shortens the words with the strategy when it is longer. When
+// the strategy is not enough, trailing words are dropped, and the first word
+// is cut as a last resort.
+func WithMaxLength(n int, strategy LengthStrategy) Option {
+	return func(str *String) {
+		str.maxLength = n
+		str.lengthStrategy = strategy
+	}
+}
+
+// WithMaxWords keeps only the first n words of the input in the output of
+// ToTitle and the converters built on Format, e.g. "user_account_settings"
+// becomes "userAccount" in camel case with n = 2. A zero n keeps every word.
+func WithMaxWords(n int) Option {
+	return func(str *String) {
+		str.maxWords = n
+	}
+}
+
+// WithHashSuffix appends the first n hex digits of the SHA-256 hash of the
+// input when WithMaxLength shortens the output, so long inputs that shorten
+// to the same words stay distinct, e.g. "user_account_3f9a1c". The hash
+// counts towards the maximum length.
+func WithHashSuffix(n int) Option {
+	return func(str *String) {
+		str.hashLength = n
+	}
+}
+
+// WithTokenHook calls hook with the index and the cased form of every word
+// before the words are joined, and uses its result instead, e.g. to mask
+// secrets or force a word to uppercase.
+func WithTokenHook(hook func(i int, token string) string) Option {
+	return func(str *String) {
+		str.tokenHook = hook
+	}
+}
+
+// WithTokenizer replaces the built-in word splitting with t. The words are
+// still cased and joined by the converters, and the input is still
+// preprocessed by options such as WithTrimPrefixes before t sees it.
+func WithTokenizer(t Tokenizer) Option {
+	return func(str *String) {
+		str.tokenizer = t
+	}
+}
+
+// LeadingInitialismPolicy controls how camel case converters, such as ToCamel,
+// lowercase an initialism at the start of the string.
+type LeadingInitialismPolicy int
+
+const (
+	// LeadingLower lowercases the whole initialism, e.g. "IDNumber" becomes
+	// "idNumber".
+	LeadingLower LeadingInitialismPolicy = iota
+
+	// LeadingLowerFirst lowercases only its first letter, e.g. "IDNumber"
+	// becomes "iDNumber".
+	LeadingLowerFirst
+
+	// LeadingError makes Strict reject input that starts with an initialism
+	// with ErrLeadingInitialism. Other converters behave like LeadingLower.
+	LeadingError
+)
+
+// WithLeadingInitialism sets how a leading initialism is lowercased.
+func WithLeadingInitialism(p LeadingInitialismPolicy) Option {
+	return func(str *String) {
+		str.leading = p
+	}
+}
+
+// AmbiguityPolicy controls what happens when joining words without a
+// separator loses a word boundary, e.g. "foo_2_bar" and "foo2_bar" both
+// become "foo2Bar" in camel case.
+type AmbiguityPolicy int
+
+const (
+	// AmbiguityIgnore joins the words as they are.
+	AmbiguityIgnore AmbiguityPolicy = iota
+
+	// AmbiguityMark inserts a marker before words that do not start with an
+	// uppercase letter, see WithAmbiguityMarker.
+	AmbiguityMark
+
+	// AmbiguityError makes Strict reject output that splits into a different
+	// number of words than the input with ErrAmbiguous. Other converters
+	// behave like AmbiguityIgnore.
+	AmbiguityError
+)
+
+// WithAmbiguityPolicy sets what happens when a word boundary is lost.
+func WithAmbiguityPolicy(p AmbiguityPolicy) Option {
+	return func(str *String) {
+		str.ambiguity = p
+	}
+}
+
+// WithAmbiguityMarker inserts the marker where joining words without a
+// separator would lose a word boundary, e.g. with WithAmbiguityMarker("_"),
+// "foo_2_bar" becomes "foo_2Bar" in camel case, while "foo2_bar" still
+// becomes "foo2Bar".
+func WithAmbiguityMarker(marker string) Option {
+	return func(str *String) {
+		str.ambiguity = AmbiguityMark
+		str.marker = marker
+	}
+}
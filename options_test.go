@@ -0,0 +1,1055 @@
+package stringcases_test
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestNewOptions(t *testing.T) {
+	t.Run("no options", func(t *testing.T) {
+		str := stringcases.New(language.English)
+		assert.Equal(t, "userAPI", str.ToCamel("user_api"))
+	})
+
+	t.Run("minor words", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithMinorWords("with", "the"))
+
+		assert.Equal(t, "Gone with the Wind", str.ToTitle("gone with the wind"))
+		assert.Equal(t, "The Lord Of the Rings", str.ToTitle("the lord of the rings"))
+	})
+}
+
+func TestWithInitialisms(t *testing.T) {
+	t.Run("replace", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithInitialisms(map[string]bool{
+			"grpc":  true,
+			"SKU":   true,
+			"OAUTH": true,
+			"ID":    false,
+		}))
+
+		assert.Equal("GRPCServer", str.ToPascal("grpc_server"))
+		assert.Equal("productSKU", str.ToCamel("product_sku"))
+		assert.Equal("OAUTHToken", str.ToPascal("oauth-token"))
+		assert.Equal("UserId", str.ToPascal("user_id"))
+	})
+
+	t.Run("extra", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithExtraInitialisms("grpc", "sku"))
+
+		assert.Equal("GRPCServer", str.ToPascal("grpc_server"))
+		assert.Equal("productSKU", str.ToCamel("product_sku"))
+		assert.Equal("UserID", str.ToPascal("user_id"))
+	})
+
+	t.Run("isolated", func(t *testing.T) {
+		stringcases.New(language.English, stringcases.WithExtraInitialisms("GRPC"))
+
+		assert.Equal(t, "GrpcServer", stringcases.ToPascal("grpc_server"))
+	})
+}
+
+func TestWithoutInitialisms(t *testing.T) {
+	assert := assert.New(t)
+
+	str := stringcases.New(language.English, stringcases.WithoutInitialisms())
+
+	assert.Equal("UserId", str.ToPascal("userId"))
+	assert.Equal("userApiKey", str.ToCamel("userAPIKey"))
+	assert.Equal("UserApiKey", str.ToPascal("userAPIKey"))
+	assert.Equal("User api key", str.ToSentence("userAPIKey"))
+	assert.Equal("user_api_key", str.ToSnake("userAPIKey"))
+}
+
+func TestWithSpecialWords(t *testing.T) {
+	str := stringcases.New(language.English, stringcases.WithSpecialWords("OAuth", "gRPC", "iOS", "macOS", "GitHub"))
+
+	tests := []struct {
+		scenario string
+		text     string
+		snake    string
+		camel    string
+		pascal   string
+	}{
+		{"oauth", "userOAuthToken", "user_oauth_token", "userOAuthToken", "UserOAuthToken"},
+		{"grpc", "grpc_server", "grpc_server", "grpcServer", "GRPCServer"},
+		{"github", "GITHUB_CLIENT", "github_client", "githubClient", "GitHubClient"},
+		{"ios", "iosAppVersion", "ios_app_version", "iosAppVersion", "IOSAppVersion"},
+		{"macos", "min-macos-version", "min_macos_version", "minMacOSVersion", "MinMacOSVersion"},
+		{"not a word", "githubs", "githubs", "githubs", "Githubs"},
+		{"initialism", "githubAPI", "github_api", "githubAPI", "GitHubAPI"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(test.snake, str.ToSnake(test.text))
+			assert.Equal(test.camel, str.ToCamel(test.text))
+			assert.Equal(test.pascal, str.ToPascal(test.text))
+		})
+	}
+
+	t.Run("sentence", func(t *testing.T) {
+		assert.Equal(t, "Sign in with GitHub", str.ToSentence("sign_in_with_github"))
+	})
+}
+
+func TestWithNumberPolicy(t *testing.T) {
+	tests := []struct {
+		text    string
+		def     string
+		split   string
+		glue    string
+		initial string
+	}{
+		{"netHTTP2", "net-http-2", "net-http-2", "net-http2", "net-http2"},
+		{"i18n", "i18n", "i-18-n", "i18n", "i-18n"},
+		{"userAPIV2", "user-api-v2", "user-api-v-2", "user-api-v2", "user-api-v-2"},
+		{"user2fa", "user2fa", "user-2-fa", "user2fa", "user-2fa"},
+		{"XY2", "xy-2", "xy-2", "xy2", "xy-2"},
+		{"HTTP2Server", "http-2-server", "http-2-server", "http2-server", "http2-server"},
+		{"2fa_code", "2fa-code", "2-fa-code", "2fa-code", "2fa-code"},
+		{"v1_2", "v1-2", "v-1-2", "v1-2", "v-1-2"},
+	}
+
+	def := stringcases.New(language.English)
+	split := stringcases.New(language.English, stringcases.WithNumberPolicy(stringcases.NumberSplit))
+	glue := stringcases.New(language.English, stringcases.WithNumberPolicy(stringcases.NumberGlue))
+	initial := stringcases.New(language.English, stringcases.WithNumberPolicy(stringcases.NumberGlueInitialism))
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(test.def, def.ToKebab(test.text))
+			assert.Equal(test.split, split.ToKebab(test.text))
+			assert.Equal(test.glue, glue.ToKebab(test.text))
+			assert.Equal(test.initial, initial.ToKebab(test.text))
+		})
+	}
+}
+
+func TestWithVersionTokens(t *testing.T) {
+	str := stringcases.New(language.English,
+		stringcases.WithVersionTokens(),
+		stringcases.WithNumberPolicy(stringcases.NumberSplit),
+	)
+
+	tests := []struct {
+		text   string
+		snake  string
+		pascal string
+	}{
+		{"apiV1Beta1", "api_v1beta1", "APIV1beta1"},
+		{"user_api_v2", "user_api_v2", "UserAPIV2"},
+		{"V10Client", "v10_client", "V10Client"},
+		{"v2rc1", "v2rc1", "V2rc1"},
+		{"dev2", "dev_2", "Dev2"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(test.snake, str.ToSnake(test.text))
+			assert.Equal(test.pascal, str.ToPascal(test.text))
+			assert.Equal(test.snake, str.ToSnake(str.ToPascal(test.text)))
+		})
+	}
+}
+
+func TestWithDelimiterRuns(t *testing.T) {
+	str := stringcases.New(language.English, stringcases.WithDelimiterRuns())
+
+	tests := []struct {
+		text  string
+		snake string
+		kebab string
+		camel string
+	}{
+		{"foo__bar", "foo__bar", "foo--bar", "fooBar"},
+		{"foo_bar", "foo_bar", "foo-bar", "fooBar"},
+		{"foo___barBaz", "foo___bar_baz", "foo---bar-baz", "fooBarBaz"},
+		{"foo - bar", "foo___bar", "foo---bar", "fooBar"},
+		{"__init__", "init", "init", "init"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(test.snake, str.ToSnake(test.text))
+			assert.Equal(test.kebab, str.ToKebab(test.text))
+			assert.Equal(test.camel, str.ToCamel(test.text))
+			assert.Equal(test.snake, str.ToSnake(str.ToKebab(test.text)))
+		})
+	}
+
+	t.Run("default collapses", func(t *testing.T) {
+		assert.Equal(t, "foo_bar", stringcases.ToSnake("foo__bar"))
+	})
+}
+
+func TestWithUnderscores(t *testing.T) {
+	str := stringcases.New(language.English, stringcases.WithUnderscores())
+
+	tests := []struct {
+		text   string
+		snake  string
+		kebab  string
+		camel  string
+		pascal string
+	}{
+		{"_private", "_private", "_private", "_private", "_Private"},
+		{"__init__", "__init__", "__init__", "__init__", "__Init__"},
+		{"id_", "id_", "id_", "id_", "ID_"},
+		{"_userName", "_user_name", "_user-name", "_userName", "_UserName"},
+		{"__", "__", "__", "__", "__"},
+		{"userName", "user_name", "user-name", "userName", "UserName"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(test.snake, str.ToSnake(test.text))
+			assert.Equal(test.kebab, str.ToKebab(test.text))
+			assert.Equal(test.camel, str.ToCamel(test.text))
+			assert.Equal(test.pascal, str.ToPascal(test.text))
+		})
+	}
+}
+
+func TestWithSeparators(t *testing.T) {
+	t.Run("keep punctuation", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithSeparators(func(r rune) bool {
+			return r == '_' || r == '-' || unicode.IsSpace(r)
+		}))
+
+		assert.Equal("src/main.go_file", str.ToSnake("src/main.go file"))
+		assert.Equal("v1.2-release-notes", str.ToKebab("v1.2 ReleaseNotes"))
+	})
+
+	t.Run("digits as separators", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithSeparators(func(r rune) bool {
+			return !unicode.IsLetter(r)
+		}))
+
+		assert.Equal("user_name", str.ToSnake("user2name"))
+		assert.Equal("UserAPIKey", str.ToPascal("user1API2key"))
+	})
+}
+
+func TestWithSymbolPolicy(t *testing.T) {
+	const text = "user's-email@domain"
+
+	t.Run("drop", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithSymbolPolicy(stringcases.SymbolDrop))
+		assert.Equal(t, "user_s_email_domain", str.ToSnake(text))
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithSymbolPlaceholder("at"))
+		assert.Equal("user_at_domain", str.ToSnake("user@domain"))
+		assert.Equal("userAtDomain", str.ToCamel("user@@domain"))
+		assert.Equal("user_at_s_email_at_domain", str.ToSnake(text))
+
+		// The placeholder is not in the input, so the first word is "Email".
+		assert.Equal("@@email_domain", str.LowerFirst("@@Email_domain"))
+		assert.Equal("#Email", str.UpperFirst("#email"))
+	})
+
+	t.Run("keep", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithSymbolPolicy(stringcases.SymbolKeep))
+		assert.Equal("user's_email@domain", str.ToSnake(text))
+		assert.Equal("user's-email@domain", str.ToKebab("User's Email@domain"))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithSymbolPolicy(stringcases.SymbolError))
+
+		_, err := str.Strict(str.ToSnake)(text)
+		assert.ErrorIs(err, stringcases.ErrDisallowedRune)
+
+		got, err := str.Strict(str.ToSnake)("user-email_domain")
+		assert.NoError(err)
+		assert.Equal("user_email_domain", got)
+	})
+}
+
+func TestWithNormalization(t *testing.T) {
+	const nfd = "cafe\u0301Menu"
+
+	t.Run("nfc", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithNormalization(norm.NFC))
+		assert.Equal("café_menu", str.ToSnake(nfd))
+		assert.Equal(str.ToSnake("caféMenu"), str.ToSnake(nfd))
+		assert.Equal("Cafe\u0301Menu", str.UpperFirst(nfd))
+	})
+
+	t.Run("nfkc", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithNormalization(norm.NFKC))
+		assert.Equal(t, "file_name", str.ToSnake("ﬁle_name"))
+	})
+
+	t.Run("default", func(t *testing.T) {
+		assert.Equal(t, "cafe_menu", stringcases.ToSnake(nfd))
+	})
+}
+
+func TestWithStripDiacritics(t *testing.T) {
+	assert := assert.New(t)
+
+	str := stringcases.New(language.English, stringcases.WithStripDiacritics())
+
+	assert.Equal("sledz-juz", str.ToKebab("Śledź już"))
+	assert.Equal("creme_brulee", str.ToSnake("Crème Brûlée"))
+	assert.Equal("cafeMenu", str.ToCamel("café menu"))
+	assert.Equal("Ærø", str.ToPascal("ærø"))
+}
+
+func TestWithTransliteration(t *testing.T) {
+	t.Run("default table", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithTransliteration(nil))
+
+		assert.Equal("strasse_aero", str.ToSnake("Straße Ærø"))
+		assert.Equal("AeroSledz", str.ToPascal("ærø śledź"))
+		assert.Equal("user_guide", str.ToSnake("user日本guide"))
+	})
+
+	t.Run("custom table", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithTransliteration(map[rune]string{
+			'ü': "ue",
+			'ö': "oe",
+			'Ü': "UE",
+		}))
+
+		assert.Equal("uebergroesse", str.ToSnake("Übergröße"))
+	})
+}
+
+func TestWithCJKPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy stringcases.CJKPolicy
+		in     string
+		want   string
+	}{
+		{"drop", stringcases.CJKDrop, "用户API", "api"},
+		{"passthrough", stringcases.CJKPassthrough, "用户API", "用户_api"},
+		{"passthrough run", stringcases.CJKPassthrough, "日本語テキスト", "日本語テキスト"},
+		{"passthrough separated", stringcases.CJKPassthrough, "user 名前 id", "user_名前_id"},
+		{"segment", stringcases.CJKSegment, "日本語テキスト", "日本語_テキスト"},
+		{"segment prolonged sound mark", stringcases.CJKSegment, "データ型", "データ_型"},
+		{"segment hangul", stringcases.CJKSegment, "사용자ID", "사용자_id"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			str := stringcases.New(language.English, stringcases.WithCJKPolicy(tc.policy))
+			assert.Equal(t, tc.want, str.ToSnake(tc.in))
+		})
+	}
+}
+
+func TestWithSharpSPolicy(t *testing.T) {
+	t.Run("expand", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.German)
+		assert.Equal("STRASSE_NAME", str.ToScreamingSnake("straßeName"))
+		assert.Equal("strasse_name", str.ToSnake(str.ToScreamingSnake("straßeName")))
+	})
+
+	t.Run("capital", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.German, stringcases.WithSharpSPolicy(stringcases.SharpSCapital))
+		assert.Equal("STRAẞE_NAME", str.ToScreamingSnake("straßeName"))
+		assert.Equal("straße_name", str.ToSnake(str.ToScreamingSnake("straßeName")))
+		assert.Equal("StraßeName", str.ToPascal("straße_name"))
+		assert.Equal("GROẞ", str.ToDelimitedUpper("groß", "_"))
+		assert.Equal("ẞtraßeName", str.ToPascal("ßtraße_name"))
+		assert.Equal("nameẞtraße", str.ToCamel("name_ßtraße"))
+		assert.Equal("2ẞe", str.ToPascal("2ße"))
+	})
+}
+
+func TestWithUTF8Policy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  stringcases.UTF8Policy
+		want    string
+		wantErr error
+	}{
+		{"error", stringcases.UTF8Error, "us_er_name", stringcases.ErrInvalidUTF8},
+		{"replace", stringcases.UTF8Replace, "us_er_name", nil},
+		{"drop", stringcases.UTF8Drop, "user_name", nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			str := stringcases.New(language.English, stringcases.WithUTF8Policy(tc.policy))
+			assert.Equal(tc.want, str.ToSnake("us\xff\xfeerName"))
+
+			got, err := str.Strict(str.ToSnake)("us\xff\xfeerName")
+			if tc.wantErr != nil {
+				assert.ErrorIs(err, tc.wantErr)
+				return
+			}
+
+			assert.NoError(err)
+			assert.Equal(tc.want, got)
+		})
+	}
+
+	t.Run("keep symbols", func(t *testing.T) {
+		str := stringcases.New(language.English,
+			stringcases.WithUTF8Policy(stringcases.UTF8Replace),
+			stringcases.WithSymbolPolicy(stringcases.SymbolKeep),
+		)
+		assert.Equal(t, "us\uFFFDer_name", str.ToSnake("us\xff\xfeerName"))
+	})
+}
+
+func TestWithNumericPatterns(t *testing.T) {
+	str := stringcases.New(language.English, stringcases.WithNumericPatterns())
+
+	tests := []struct {
+		name   string
+		text   string
+		snake  string
+		pascal string
+	}{
+		{"date", "backup20240101Final", "backup_20240101_final", "Backup20240101Final"},
+		{"timestamp", "log20240101235959", "log_20240101235959", "Log20240101235959"},
+		{"invalid date", "build20241301", "build20241301", "Build20241301"},
+		{"hex", "color0xFF", "color_0xFF", "Color0xFF"},
+		{"hex before word", "0xFFColor", "0xFF_color", "0xFFColor"},
+		{"uuid", "id_550E8400-E29B-41D4-A716-446655440000", "id_550E8400-E29B-41D4-A716-446655440000", "ID550E8400-E29B-41D4-A716-446655440000"},
+		{"short hex", "0x", "0x", "0X"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(test.snake, str.ToSnake(test.text))
+			assert.Equal(test.pascal, str.ToPascal(test.text))
+		})
+	}
+
+	t.Run("spans", func(t *testing.T) {
+		assert.Equal(t, []stringcases.Span{
+			{Word: "color", Start: 0, End: 5},
+			{Word: "0xFF", Start: 5, End: 9},
+		}, str.TokenizeSpans("color0xFF"))
+	})
+
+	t.Run("units", func(t *testing.T) {
+		str := stringcases.New(language.English,
+			stringcases.WithNumericPatterns(),
+			stringcases.WithNumberPolicy(stringcases.NumberUnits),
+		)
+		assert.Equal(t, "max_10mb_since_20240101", str.ToSnake("max10MBSince20240101"))
+	})
+}
+
+func TestWithGraphemeClusters(t *testing.T) {
+	str := stringcases.New(language.English, stringcases.WithGraphemeClusters())
+
+	t.Run("combining marks", func(t *testing.T) {
+		assert := assert.New(t)
+		assert.Equal([]string{"cafe\u0301s", "menu"}, str.Tokenize("cafe\u0301s_menu"))
+		assert.Equal("Cafe\u0301sMenu", str.ToPascal("cafe\u0301s_menu"))
+		assert.Equal([]string{"cafe", "s", "menu"}, stringcases.Tokenize("cafe\u0301s_menu"))
+
+		str := stringcases.New(language.English,
+			stringcases.WithGraphemeClusters(),
+			stringcases.WithAcronymBoundary(stringcases.AcronymBoundaryLastUpper),
+		)
+		assert.Equal("E\u0301COLE_NAME", str.ToScreamingSnake("E\u0301COLEName"))
+	})
+
+	t.Run("emoji", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English,
+			stringcases.WithGraphemeClusters(),
+			stringcases.WithEmojiPolicy(stringcases.EmojiToken),
+		)
+		assert.Equal([]string{"dev", "\U0001F469\u200d\U0001F4BB", "team"}, str.Tokenize("dev\U0001F469\u200d\U0001F4BBteam"))
+		assert.Equal([]string{"\U0001F1EF\U0001F1F5", "\U0001F1FA\U0001F1F8"}, str.Tokenize("\U0001F1EF\U0001F1F5\U0001F1FA\U0001F1F8"))
+		assert.Equal([]string{"wave", "\U0001F44B\U0001F3FD"}, str.Tokenize("wave\U0001F44B\U0001F3FD"))
+	})
+
+	t.Run("skipped clusters", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English,
+			stringcases.WithGraphemeClusters(),
+			stringcases.WithDelimiterRuns(),
+		)
+		assert.Equal("user_name", str.ToSnake("user\u2014\u0301name"))
+
+		str = stringcases.New(language.English, stringcases.WithDelimiterRuns())
+		assert.Equal("user__name", str.ToSnake("user\u2014\u0301name"))
+	})
+
+	t.Run("invisible", func(t *testing.T) {
+		assert.Equal(t, "username", str.ToSnake("user\u200dname"))
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		str := stringcases.New(language.English,
+			stringcases.WithGraphemeClusters(),
+			stringcases.WithEmojiPolicy(stringcases.EmojiToken),
+		)
+		_, err := str.Strict(str.ToSnake)("dev\U0001F469\u200d\U0001F4BB")
+		assert.NoError(t, err)
+	})
+}
+
+func TestWithRomanNumerals(t *testing.T) {
+	str := stringcases.New(language.English, stringcases.WithRomanNumerals())
+
+	tests := []struct {
+		text     string
+		pascal   string
+		camel    string
+		snake    string
+		sentence string
+	}{
+		{"HenryVIIIPortrait", "HenryVIIIPortrait", "henryVIIIPortrait", "henry_viii_portrait", "Henry VIII portrait"},
+		{"phaseIIReport", "PhaseIIReport", "phaseIIReport", "phase_ii_report", "Phase II report"},
+		{"LouisXIV", "LouisXIV", "louisXIV", "louis_xiv", "Louis XIV"},
+		{"henry_viii", "HenryViii", "henryViii", "henry_viii", "Henry viii"},
+		{"MIX_VALUE", "MixValue", "mixValue", "mix_value", "Mix value"},
+		{"IIFoo", "IIFoo", "iiFoo", "ii_foo", "II foo"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(test.pascal, str.ToPascal(test.text))
+			assert.Equal(test.camel, str.ToCamel(test.text))
+			assert.Equal(test.snake, str.ToSnake(test.text))
+			assert.Equal(test.sentence, str.ToSentence(test.text))
+		})
+	}
+
+	t.Run("default", func(t *testing.T) {
+		assert.Equal(t, "HenryViiiPortrait", stringcases.ToPascal("Henry_VIII_portrait"))
+	})
+}
+
+func TestWithEmojiPolicy(t *testing.T) {
+	t.Run("symbol", func(t *testing.T) {
+		assert := assert.New(t)
+		assert.Equal("i_go", stringcases.ToSnake("I\u2764\ufe0fGo"))
+		assert.Equal("great-launch", stringcases.ToKebab("Great \U0001f680 launch"))
+
+		str := stringcases.New(language.English, stringcases.WithSymbolPlaceholder("emoji"))
+		assert.Equal("great_emoji_launch", str.ToSnake("Great \U0001f680 launch"))
+	})
+
+	t.Run("token", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithEmojiPolicy(stringcases.EmojiToken))
+		assert.Equal("i_\u2764\ufe0f_go", str.ToSnake("I\u2764\ufe0fGo"))
+		assert.Equal("great-\U0001f680-\U0001f680-launch", str.ToKebab("Great \U0001f680\U0001f680 launch"))
+		assert.Equal("thumbs\U0001f44d\U0001f3fdUp", str.ToCamel("thumbs \U0001f44d\U0001f3fd up"))
+		assert.ErrorIs(str.ValidateSeparator("\U0001f680"), stringcases.ErrInvalidSeparator)
+	})
+
+	t.Run("separator", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English,
+			stringcases.WithEmojiPolicy(stringcases.EmojiSeparator),
+			stringcases.WithSymbolPolicy(stringcases.SymbolKeep),
+		)
+		assert.Equal("c++_rocks", str.ToSnake("C++\U0001f680rocks"))
+		assert.NoError(str.ValidateSeparator("\U0001f680"))
+	})
+}
+
+func TestWithWhitespacePolicy(t *testing.T) {
+	t.Run("separate", func(t *testing.T) {
+		tests := []struct {
+			name string
+			text string
+		}{
+			{"tab", "user\tname"},
+			{"no-break space", "user\u00a0name"},
+			{"narrow no-break space", "user\u202fname"},
+			{"em space", "user\u2003name"},
+			{"ideographic space", "user\u3000name"},
+			{"line separator", "user\u2028name"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				assert.Equal(t, "user_name", stringcases.ToSnake(test.text))
+			})
+		}
+
+		str := stringcases.New(language.English, stringcases.WithSymbolPolicy(stringcases.SymbolKeep))
+		assert.Equal(t, "user_name", str.ToSnake("user\u00a0name"))
+	})
+
+	t.Run("ignore", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithWhitespacePolicy(stringcases.WhitespaceIgnore))
+		assert.Equal("max_1000", str.ToSnake("max 1\u202f000"))
+		assert.Equal("username", str.ToSnake("user\u00a0name"))
+		assert.Equal("user_name", str.ToSnake("user\tname"))
+		assert.Equal("userName", str.ToCamel("user name"))
+	})
+}
+
+func TestWithStripHungarian(t *testing.T) {
+	t.Run("default prefixes", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithStripHungarian())
+
+		tests := map[string]string{
+			"m_strUserName": "user_name",
+			"bIsEnabled":    "is_enabled",
+			"nCount":        "count",
+			"pNext":         "next",
+			"number":        "number",
+			"bucketName":    "bucket_name",
+			"m_":            "m",
+		}
+
+		for in, want := range tests {
+			assert.Equal(t, want, str.ToSnake(in), in)
+		}
+	})
+
+	t.Run("custom prefixes", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithStripHungarian("g_", "lp", "lpsz", "dw"))
+		assert.Equal("user_name", str.ToSnake("g_lpszUserName"))
+		assert.Equal("flags", str.ToSnake("dwFlags"))
+		assert.Equal("str_name", str.ToSnake("strName"))
+	})
+}
+
+func TestWithTrimAffixes(t *testing.T) {
+	str := stringcases.New(language.English,
+		stringcases.WithTrimPrefixes("tbl_", "fld_"),
+		stringcases.WithTrimSuffixes("_id"),
+	)
+
+	tests := []struct {
+		in, want string
+	}{
+		{"tbl_user_accounts", "UserAccounts"},
+		{"fld_author_id", "Author"},
+		{"TBL_ORDERS", "Orders"},
+		{"user_accounts", "UserAccounts"},
+		{"tbl_", "Tbl"},
+		{"tbl_fld_name", "FldName"},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.want, str.ToPascal(tc.in), tc.in)
+	}
+}
+
+func TestWithAbbreviations(t *testing.T) {
+	assert := assert.New(t)
+
+	str := stringcases.New(language.English, stringcases.WithAbbreviations(map[string]string{
+		"configuration": "cfg",
+		"Number":        "num",
+		"identifier":    "ID",
+	}))
+
+	assert.Equal("cfg_num", str.ToSnake("ConfigurationNumber"))
+	assert.Equal("CfgNum", str.ToPascal("configuration number"))
+	assert.Equal("userID", str.ToCamel("user_identifier"))
+	assert.Equal("user-name", str.ToKebab("userName"))
+
+	// Only the case of the first word changes, it is not abbreviated.
+	assert.Equal("ConfigurationNumber", str.UpperFirst("configurationNumber"))
+	assert.Equal("numberConfiguration", str.LowerFirst("NumberConfiguration"))
+}
+
+func TestWithExpansions(t *testing.T) {
+	assert := assert.New(t)
+
+	str := stringcases.New(language.English, stringcases.WithExpansions(map[string]string{
+		"cfg":  "configuration",
+		"SRV":  "server",
+		"addr": "address",
+		"tz":   "time zone",
+	}))
+
+	assert.Equal("ConfigurationServerAddress", str.ToPascal("cfg_srv_addr"))
+	assert.Equal("Configuration server address", str.ToHuman("cfgSrvAddr"))
+	assert.Equal("user_time_zone", str.ToSnake("userTZ"))
+	assert.Equal("user_name", str.ToSnake("userName"))
+
+	// Only the case of the first word changes, it is not expanded.
+	assert.Equal("CfgSrvAddr", str.UpperFirst("cfgSrvAddr"))
+	assert.Equal("tz_offset", str.LowerFirst("Tz_offset"))
+}
+
+func TestWithTokenHook(t *testing.T) {
+	assert := assert.New(t)
+
+	str := stringcases.New(language.English, stringcases.WithTokenHook(func(i int, token string) string {
+		switch strings.ToLower(token) {
+		case "password":
+			return "***"
+		case "db":
+			return strings.ToUpper(token)
+		}
+
+		return token
+	}))
+
+	assert.Equal("user_***", str.ToSnake("userPassword"))
+	assert.Equal("DB_host", str.ToSnake("dbHost"))
+	assert.Equal("DBHost", str.ToCamel("db_host"))
+	assert.Equal("The *** of DB", str.ToTitle("the password of db"))
+
+	var indexes []int
+	str = stringcases.New(language.English, stringcases.WithTokenHook(func(i int, token string) string {
+		indexes = append(indexes, i)
+
+		return token
+	}))
+	assert.Equal("a-b-c", str.ToKebab("a b c"))
+	assert.Equal([]int{0, 1, 2}, indexes)
+}
+
+func TestWithTokenizer(t *testing.T) {
+	assert := assert.New(t)
+
+	str := stringcases.New(language.English, stringcases.WithTokenizer(stringcases.TokenizerFunc(func(s string) []string {
+		return strings.FieldsFunc(s, func(r rune) bool {
+			return r == '.'
+		})
+	})))
+
+	assert.Equal("user_name_id", str.ToSnake("user.name.id"))
+	assert.Equal("userNameID", str.ToCamel("user.name.id"))
+	assert.Equal("username", str.ToSnake("UserName"))
+	assert.Equal("", str.ToSnake(""))
+}
+
+func TestWithLeadingInitialism(t *testing.T) {
+	t.Run("lower", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithLeadingInitialism(stringcases.LeadingLower))
+		assert.Equal(t, "idNumber", str.ToCamel("IDNumber"))
+	})
+
+	t.Run("lower first", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithLeadingInitialism(stringcases.LeadingLowerFirst))
+		assert.Equal("iDNumber", str.ToCamel("IDNumber"))
+		assert.Equal("hTTPServer", str.ToCamel("http_server"))
+		assert.Equal("userID", str.ToCamel("user_id"))
+		assert.Equal("id_number", str.ToSnake("IDNumber"))
+		assert.Equal("idNumber", str.ToCamelLower("IDNumber"))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithLeadingInitialism(stringcases.LeadingError))
+		assert.Equal("idNumber", str.ToCamel("IDNumber"))
+
+		_, err := str.Strict(str.ToCamel)("IDNumber")
+		assert.ErrorIs(err, stringcases.ErrLeadingInitialism)
+		assert.EqualError(err, `stringcases: leading initialism: "ID"`)
+
+		got, err := str.Strict(str.ToCamel)("numberID")
+		assert.NoError(err)
+		assert.Equal("numberID", got)
+	})
+}
+
+func TestWithAcronymBoundary(t *testing.T) {
+	tests := []struct {
+		text         string
+		greedy, last string
+	}{
+		{"IDcard", "id-card", "i-dcard"},
+		{"APIkey", "api-key", "ap-ikey"},
+		{"HTTPAPIkey", "http-api-key", "http-ap-ikey"},
+		{"sHTTPb", "s-http-b", "s-htt-pb"},
+		{"HTMLParser", "html-parser", "html-parser"},
+		{"userIDs", "user-ids", "user-ids"},
+		{"ABcd", "ab-cd", "a-bcd"},
+		{"ABCdef", "abc-def", "ab-cdef"},
+		{"ABCs", "abc-s", "ab-cs"},
+	}
+
+	greedy := stringcases.New(language.English, stringcases.WithAcronymBoundary(stringcases.AcronymBoundaryGreedy))
+	last := stringcases.New(language.English, stringcases.WithAcronymBoundary(stringcases.AcronymBoundaryLastUpper))
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(test.greedy, stringcases.ToKebab(test.text))
+			assert.Equal(test.greedy, greedy.ToKebab(test.text))
+			assert.Equal(test.last, last.ToKebab(test.text))
+		})
+	}
+
+	t.Run("default", func(t *testing.T) {
+		assert.Equal(t, "api_key", stringcases.ToSnake("APIkey"))
+	})
+}
+
+func TestWithUppercaseAcronyms(t *testing.T) {
+	str := stringcases.New(language.English, stringcases.WithUppercaseAcronyms())
+
+	tests := []struct {
+		in, snake, camel, pascal string
+	}{
+		{"NASAProgram", "nasa_program", "nasaProgram", "NASAProgram"},
+		{"launchNASAProgram", "launch_nasa_program", "launchNASAProgram", "LaunchNASAProgram"},
+		{"HTTPServer", "http_server", "httpServer", "HTTPServer"},
+		{"ABcd", "ab_cd", "abCd", "ABCd"},
+		{"userID", "user_id", "userID", "UserID"},
+		{"aBC", "a_bc", "aBC", "ABC"},
+		{"iPhone", "i_phone", "iPhone", "IPhone"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tc.snake, str.ToSnake(tc.in))
+			assert.Equal(tc.camel, str.ToCamel(tc.in))
+			assert.Equal(tc.pascal, str.ToPascal(tc.in))
+		})
+	}
+
+	t.Run("default", func(t *testing.T) {
+		assert.Equal(t, "NasaProgram", stringcases.ToPascal("NASA_program"))
+	})
+}
+
+func TestNumberUnits(t *testing.T) {
+	str := stringcases.New(language.English, stringcases.WithNumberPolicy(stringcases.NumberUnits))
+
+	tests := map[string]string{
+		"max10MBUpload":   "max-10mb-upload",
+		"retry3rdAttempt": "retry-3rd-attempt",
+		"timeout5s":       "timeout-5s",
+		"width100px":      "width-100px",
+		"the1stPlace":     "the-1st-place",
+		"i18n":            "i18n",
+		"userV2":          "user-v2",
+		"5seconds":        "5seconds",
+		"size10 MB":       "size10-mb",
+	}
+
+	for in, want := range tests {
+		assert.Equal(t, want, str.ToKebab(in), in)
+	}
+
+	assert.Equal(t, "MAX_10MB_UPLOAD", str.ToScreamingSnake("max10MBUpload"))
+}
+
+func TestWithMinimalChanges(t *testing.T) {
+	str := stringcases.New(language.English, stringcases.WithMinimalChanges())
+
+	tests := []struct {
+		name string
+		fn   func(string) string
+		in   string
+		want string
+	}{
+		{"pascal keeps title word", str.ToPascal, "UserApiKey", "UserApiKey"},
+		{"pascal keeps initialism", str.ToPascal, "UserAPIKey", "UserAPIKey"},
+		{"pascal keeps unknown caps", str.ToPascal, "NASA_program", "NASAProgram"},
+		{"pascal recases lowercase", str.ToPascal, "user_api_key", "UserAPIKey"},
+		{"camel", str.ToCamel, "user_Api_key", "userApiKey"},
+		{"camel lowercases first word", str.ToCamel, "User_Api_Key", "userApiKey"},
+		{"snake", str.ToSnake, "userAPIKey", "user_api_key"},
+		{"screaming snake", str.ToScreamingSnake, "userApiKey", "USER_API_KEY"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.fn(tc.in))
+		})
+	}
+}
+
+func TestWithAmbiguityPolicy(t *testing.T) {
+	t.Run("ignore", func(t *testing.T) {
+		assert := assert.New(t)
+		assert.Equal("foo2Bar", stringcases.ToCamel("foo_2_bar"))
+		assert.Equal("foo2Bar", stringcases.ToCamel("foo2_bar"))
+	})
+
+	t.Run("mark", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithAmbiguityMarker("_"))
+		assert.Equal("foo_2Bar", str.ToCamel("foo_2_bar"))
+		assert.Equal("foo2Bar", str.ToCamel("foo2_bar"))
+		assert.Equal("Foo_2Bar", str.ToPascal("foo 2 bar"))
+		assert.Equal("foo_2_bar", str.ToSnake("foo_2_bar"))
+		assert.Equal("user_api", str.ToDelimited("userAPI", ""))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithAmbiguityPolicy(stringcases.AmbiguityError))
+		assert.Equal("foo2Bar", str.ToCamel("foo_2_bar"))
+
+		_, err := str.Strict(str.ToCamel)("foo_2_bar")
+		assert.ErrorIs(err, stringcases.ErrAmbiguous)
+
+		got, err := str.Strict(str.ToCamel)("foo2_bar")
+		assert.NoError(err)
+		assert.Equal("foo2Bar", got)
+	})
+}
+
+func TestWithContractions(t *testing.T) {
+	t.Run("ignore", func(t *testing.T) {
+		assert.Equal(t, "Dont retry", stringcases.ToHuman("dont_retry"))
+	})
+
+	t.Run("apostrophe", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithContractions(stringcases.ContractionApostrophe, nil))
+		assert.Equal("Don't retry", str.ToHuman("dont_retry"))
+		assert.Equal("Don't retry", str.ToHuman("don't retry"))
+		assert.Equal("Retry if you can't connect", str.ToHuman("retryIfYouCantConnect"))
+		assert.Equal("I'm ready", str.ToHuman("im_ready"))
+		assert.Equal("Its value", str.ToHuman("its_value"))
+		assert.Equal("dont_retry", str.FromHuman(str.ToHuman("dont_retry")))
+	})
+
+	t.Run("expand", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithContractions(stringcases.ContractionExpand, map[string]string{
+			"ain't": "is not",
+		}))
+		assert.Equal("Do not retry", str.ToHuman("dont_retry"))
+		assert.Equal("Retry if you cannot connect", str.ToHuman("retryIfYouCantConnect"))
+		assert.Equal("It is not done", str.ToHuman("itAintDone"))
+		assert.Equal("I am ready", str.ToHuman("im_ready"))
+	})
+
+	t.Run("override", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithContractions(stringcases.ContractionExpand, map[string]string{
+			"can't": "can not",
+		}))
+		assert.Equal(t, "Can not retry", str.ToHuman("cant_retry"))
+	})
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []stringcases.Option
+		err  error
+	}{
+		{"invalid policy", []stringcases.Option{stringcases.WithSymbolPolicy(42)}, stringcases.ErrInvalidOption},
+		{"invalid acronym boundary policy", []stringcases.Option{stringcases.WithAcronymBoundary(3)}, stringcases.ErrInvalidOption},
+		{"invalid emoji policy", []stringcases.Option{stringcases.WithEmojiPolicy(3)}, stringcases.ErrInvalidOption},
+		{"invalid whitespace policy", []stringcases.Option{stringcases.WithWhitespacePolicy(-1)}, stringcases.ErrInvalidOption},
+		{"invalid contraction policy", []stringcases.Option{stringcases.WithContractions(42, nil)}, stringcases.ErrInvalidOption},
+		{"negative max length", []stringcases.Option{stringcases.WithMaxLength(-1, stringcases.TruncateWords)}, stringcases.ErrInvalidOption},
+		{"grapheme clusters with tokenizer", []stringcases.Option{
+			stringcases.WithGraphemeClusters(),
+			stringcases.WithTokenizer(stringcases.TokenizerFunc(strings.Fields)),
+		}, stringcases.ErrConflictingOptions},
+		{"negative max words", []stringcases.Option{stringcases.WithMaxWords(-1)}, stringcases.ErrInvalidOption},
+		{"empty placeholder", []stringcases.Option{stringcases.WithSymbolPlaceholder("")}, stringcases.ErrInvalidOption},
+		{"empty marker", []stringcases.Option{stringcases.WithAmbiguityMarker("")}, stringcases.ErrInvalidSeparator},
+		{"letter marker", []stringcases.Option{stringcases.WithAmbiguityMarker("x")}, stringcases.ErrInvalidSeparator},
+		{"units with tokenizer", []stringcases.Option{
+			stringcases.WithNumberPolicy(stringcases.NumberUnits),
+			stringcases.WithTokenizer(stringcases.TokenizerFunc(strings.Fields)),
+		}, stringcases.ErrConflictingNumberPolicy},
+		{"numeric patterns with tokenizer", []stringcases.Option{
+			stringcases.WithNumericPatterns(),
+			stringcases.WithTokenizer(stringcases.TokenizerFunc(strings.Fields)),
+		}, stringcases.ErrConflictingOptions},
+		{"hash without max length", []stringcases.Option{stringcases.WithHashSuffix(6)}, stringcases.ErrConflictingOptions},
+		{"acronyms without initialisms", []stringcases.Option{
+			stringcases.WithUppercaseAcronyms(),
+			stringcases.WithoutInitialisms(),
+		}, stringcases.ErrConflictingOptions},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				err, _ := recover().(error)
+				assert.ErrorIs(t, err, tc.err)
+			}()
+
+			stringcases.New(language.English, tc.opts...)
+		})
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		str := stringcases.New(language.English,
+			stringcases.WithMaxLength(20, stringcases.DropMiddleWords),
+			stringcases.WithHashSuffix(6),
+			stringcases.WithAmbiguityMarker("_"),
+		)
+		assert.NoError(t, str.Validate())
+	})
+
+	t.Run("multiple", func(t *testing.T) {
+		defer func() {
+			err, _ := recover().(error)
+			assert.ErrorIs(t, err, stringcases.ErrInvalidOption)
+			assert.ErrorIs(t, err, stringcases.ErrConflictingOptions)
+		}()
+
+		stringcases.New(language.English,
+			stringcases.WithCJKPolicy(-1),
+			stringcases.WithHashSuffix(6),
+		)
+	})
+}
@@ -0,0 +1,180 @@
+package stringcases
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// asciiFold maps letters that do not decompose into an ASCII base letter to
+// their usual ASCII spelling.
+var asciiFold = map[rune]string{
+	'ß': "ss", 'ẞ': "SS",
+	'æ': "ae", 'Æ': "AE",
+	'œ': "oe", 'Œ': "OE",
+	'ø': "o", 'Ø': "O",
+	'ł': "l", 'Ł': "L",
+	'đ': "d", 'Đ': "D",
+	'ð': "d", 'Ð': "D",
+	'þ': "th", 'Þ': "TH",
+	'ı': "i",
+}
+
+// prepare rewrites the input before it is tokenized. It also returns the
+// number of bytes removed from the start of the input, so that offsets in the
+// result can be mapped back.
+func (str *String) prepare(s string) (string, int) {
+	if str.invalidUTF8 == UTF8Drop {
+		s = strings.ToValidUTF8(s, "")
+	} else {
+		s = strings.ToValidUTF8(s, string(utf8.RuneError))
+	}
+
+	if strings.IndexFunc(s, isInvisible) >= 0 {
+		s = str.removeInvisible(s)
+	}
+
+	s, offset := str.trim(s)
+	if str.whitespace == WhitespaceIgnore {
+		s = strings.Map(func(r rune) rune {
+			if r > unicode.MaxASCII && unicode.IsSpace(r) {
+				return -1
+			}
+
+			return r
+		}, s)
+	}
+	if str.normalize {
+		s = str.form.String(s)
+	}
+	if str.diacritics {
+		s = stripDiacritics(s)
+	}
+	if str.transliteration != nil {
+		s = transliterate(s, str.transliteration)
+	}
+
+	return s, offset
+}
+
+// trim removes the affixes and Hungarian prefixes of s, and returns the
+// number of bytes removed from its start.
+func (str *String) trim(s string) (string, int) {
+	s, offset := trimAffixes(s, str.trimPrefixes, str.trimSuffixes)
+	if str.hungarian != nil {
+		t := stripHungarian(s, str.hungarian)
+		offset += len(s) - len(t)
+		s = t
+	}
+
+	return s, offset
+}
+
+// isInvisible reports whether r is a format or control character other than
+// whitespace, such as the zero-width space U+200B, the zero-width joiner
+// U+200D, the byte order mark U+FEFF and the soft hyphen U+00AD, which are
+// often pasted along with text from word processors. They are removed before
+// tokenizing, so they cannot split words.
+func isInvisible(r rune) bool {
+	return unicode.Is(unicode.Cf, r) || unicode.IsControl(r) && !unicode.IsSpace(r)
+}
+
+// trimAffixes removes the first of prefixes that s starts with and the first
+// of suffixes that it ends with, ignoring case, and returns the length of the
+// prefix removed. Affixes that would leave nothing are kept, so "tbl_" stays
+// "tbl_".
+func trimAffixes(s string, prefixes, suffixes []string) (string, int) {
+	var n int
+	for _, p := range prefixes {
+		if len(p) < len(s) && strings.EqualFold(s[:len(p)], p) {
+			s = s[len(p):]
+			n = len(p)
+
+			break
+		}
+	}
+
+	for _, p := range suffixes {
+		if len(p) < len(s) && strings.EqualFold(s[len(s)-len(p):], p) {
+			s = s[:len(s)-len(p)]
+
+			break
+		}
+	}
+
+	return s, n
+}
+
+// stripHungarian repeatedly removes the leading prefix of s, e.g.
+// "m_strUserName" becomes "UserName". Prefixes ending with "_" are always
+// removed, while others must be followed by an uppercase letter, so "number"
+// keeps its "n". The prefixes are sorted from longest to shortest.
+func stripHungarian(s string, prefixes []string) string {
+	for {
+		var ok bool
+		for _, p := range prefixes {
+			rest, found := strings.CutPrefix(s, p)
+			if !found || rest == "" {
+				continue
+			}
+
+			if r, _ := utf8.DecodeRuneInString(rest); strings.HasSuffix(p, "_") || unicode.IsUpper(r) {
+				s, ok = rest, true
+
+				break
+			}
+		}
+
+		if !ok {
+			return s
+		}
+	}
+}
+
+// stripDiacritics removes the combining marks of s, e.g. "Śledź" becomes
+// "Sledz".
+func stripDiacritics(s string) string {
+	return norm.NFC.String(strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+
+		return r
+	}, norm.NFD.String(s)))
+}
+
+// transliterate converts s into ASCII by replacing the runes in table and
+// stripping diacritics from the rest. Other non-ASCII runes are replaced with
+// a space, so they still separate words.
+func transliterate(s string, table map[rune]string) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+
+	runes := []rune(s)
+	for i, r := range runes {
+		if f := table[r]; f != "" {
+			// Keep "Æro" as a single word by folding it to "Aero" rather
+			// than "AEro".
+			if len(f) > 1 && isUpper([]rune(f)) && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+				f = f[:1] + strings.ToLower(f[1:])
+			}
+			sb.WriteString(f)
+
+			continue
+		}
+
+		for _, r := range norm.NFD.String(string(r)) {
+			switch {
+			case r <= unicode.MaxASCII:
+				sb.WriteRune(r)
+			case unicode.Is(unicode.Mn, r):
+			default:
+				sb.WriteByte(' ')
+			}
+		}
+	}
+
+	return sb.String()
+}
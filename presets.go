@@ -0,0 +1,34 @@
+package stringcases
+
+import "golang.org/x/text/language"
+
+// NewGoStyle returns an instance for Go identifiers. Initialisms are
+// uppercased and keep their numbers, e.g. "user_id" becomes "UserID" and
+// "http2_server" becomes "HTTP2Server" in Pascal case.
+func NewGoStyle(opts ...Option) *String {
+	return New(language.English, append([]Option{
+		WithNumberPolicy(NumberGlueInitialism),
+	}, opts...)...)
+}
+
+// NewJSONStyle returns an instance for JSON field names, which capitalize
+// initialisms like other words, e.g. "user_id" becomes "userId" and
+// "HTTPServer" becomes "httpServer" in camel case.
+func NewJSONStyle(opts ...Option) *String {
+	return New(language.English, append([]Option{
+		WithoutInitialisms(),
+		WithNumberPolicy(NumberGlue),
+	}, opts...)...)
+}
+
+// NewJSStyle is an alias of NewJSONStyle for JavaScript identifiers, which
+// capitalize initialisms like JSON field names, e.g. "XMLHttpRequest" becomes
+// "xmlHttpRequest" in camel case.
+var NewJSStyle = NewJSONStyle
+
+// NewRailsStyle is an alias of NewJSONStyle for Ruby on Rails names, since
+// ActiveSupport has no acronyms by default and glues numbers to the word
+// before them, e.g. "Version2API" becomes "version2_api" in snake case and
+// "user_id" becomes "UserId" in Pascal case. Words are not pluralized or
+// singularized like Rails table names; pass WithTokenHook to do that.
+var NewRailsStyle = NewJSONStyle
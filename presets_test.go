@@ -0,0 +1,45 @@
+package stringcases_test
+
+import (
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresets(t *testing.T) {
+	t.Run("go", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.NewGoStyle()
+		assert.Equal("UserID", str.ToPascal("user_id"))
+		assert.Equal("HTTP2Server", str.ToPascal("http2_server"))
+		assert.Equal("user2FaCode", str.ToCamel("user_2fa_code"))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.NewJSONStyle()
+		assert.Equal("userId", str.ToCamel("user_id"))
+		assert.Equal("httpServer", str.ToCamel("HTTPServer"))
+		assert.Equal("apiV2Client", str.ToCamel("API_V2_CLIENT"))
+	})
+
+	t.Run("js", func(t *testing.T) {
+		assert.Equal(t, "xmlHttpRequest", stringcases.NewJSStyle().ToCamel("XMLHttpRequest"))
+	})
+
+	t.Run("rails", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.NewRailsStyle()
+		assert.Equal("version2_api", str.ToSnake("Version2API"))
+		assert.Equal("UserId", str.ToPascal("user_id"))
+	})
+
+	t.Run("extra options", func(t *testing.T) {
+		str := stringcases.NewGoStyle(stringcases.WithExtraInitialisms("SKU"))
+		assert.Equal(t, "ProductSKU", str.ToPascal("product_sku"))
+	})
+}
@@ -0,0 +1,62 @@
+package stringcases
+
+import (
+	"sort"
+	"sync"
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]func(string) string{
+		"snake":           ToSnake,
+		"kebab":           ToKebab,
+		"camel":           ToCamel,
+		"pascal":          ToPascal,
+		"screaming-snake": ToScreamingSnake,
+		"no-case":         ToNoCase,
+		"title":           ToTitle,
+		"sentence":        ToSentence,
+		"human":           ToHuman,
+		"header":          ToHeader,
+	}
+)
+
+// Register makes a converter available under the name. It panics if the
+// converter is nil or the name is already registered.
+func Register(name string, fn func(string) string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if fn == nil {
+		panic("stringcases: Register converter is nil")
+	}
+	if _, ok := registry[name]; ok {
+		panic("stringcases: Register called twice for " + name)
+	}
+
+	registry[name] = fn
+}
+
+// Get returns the converter registered under the name.
+func Get(name string) (func(string) string, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	fn, ok := registry[name]
+
+	return fn, ok
+}
+
+// Registered returns the sorted names of the registered converters.
+func Registered() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
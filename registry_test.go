@@ -0,0 +1,66 @@
+package stringcases_test
+
+import (
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("builtin", func(t *testing.T) {
+		tests := []struct {
+			name string
+			want string
+		}{
+			{"snake", "user_api_key"},
+			{"kebab", "user-api-key"},
+			{"camel", "userAPIKey"},
+			{"pascal", "UserAPIKey"},
+			{"screaming-snake", "USER_API_KEY"},
+			{"no-case", "user api key"},
+			{"title", "User API Key"},
+			{"sentence", "User API key"},
+			{"human", "User API key"},
+			{"header", "User-API-Key"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				assert := assert.New(t)
+
+				fn, ok := stringcases.Get(test.name)
+				assert.True(ok)
+				assert.Equal(test.want, fn("userAPIKey"))
+			})
+		}
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		assert := assert.New(t)
+
+		stringcases.Register("dot", stringcases.Formatter(stringcases.Format{Separator: "."}))
+
+		fn, ok := stringcases.Get("dot")
+		assert.True(ok)
+		assert.Equal("user.api.key", fn("userAPIKey"))
+		assert.Contains(stringcases.Registered(), "dot")
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, ok := stringcases.Get("unknown")
+		assert.False(t, ok)
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		assert.Panics(t, func() {
+			stringcases.Register("snake", stringcases.ToSnake)
+		})
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Panics(t, func() {
+			stringcases.Register("nil", nil)
+		})
+	})
+}
@@ -0,0 +1,50 @@
+package stringcases
+
+import "strings"
+
+var FormatMap = s.FormatMap
+
+// Mapping is a word of the output with its byte offsets, and the word of the
+// input that it came from.
+type Mapping struct {
+	Span
+	Source Span
+}
+
+// FormatMap converts s like Formatter, and also maps every word of the
+// output back to the input, e.g. "userAPI_Key" becomes "user_api_key" in
+// snake case, where "api" at [5, 8) comes from "API" at [4, 7), so editors
+// can keep the cursor in place and refactors can rename parts of a name. The
+// input offsets are as exact as those of TokenizeSpans. When WithMaxLength
+// shortens the output, the words no longer match the input, and the mapping
+// is nil.
+func (str *String) FormatMap(s string, f Format) (string, []Mapping) {
+	spans := str.TokenizeSpans(s)
+	tokens := make([]string, len(spans))
+	for i, span := range spans {
+		tokens[i] = span.Word
+	}
+
+	out, words := str.formatTokens(s, tokens, f)
+
+	// Leading underscores are kept verbatim with WithUnderscores.
+	var start int
+	if str.underscores {
+		start = len(s) - len(strings.TrimLeft(s, "_"))
+	}
+	if !strings.HasPrefix(out[start:], strings.Join(words, f.Separator)) {
+		return out, nil
+	}
+
+	var m []Mapping
+	for i, w := range words {
+		if i > 0 {
+			start += len(f.Separator)
+		}
+
+		m = append(m, Mapping{Span: Span{Word: w, Start: start, End: start + len(w)}, Source: spans[i]})
+		start += len(w)
+	}
+
+	return out, m
+}
@@ -0,0 +1,60 @@
+package stringcases_test
+
+import (
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestFormatMap(t *testing.T) {
+	snake := stringcases.Format{Separator: "_"}
+	pascal := stringcases.Format{First: stringcases.WordTitle, Rest: stringcases.WordTitle, Initialisms: stringcases.TitleInitialisms}
+
+	t.Run("snake", func(t *testing.T) {
+		assert := assert.New(t)
+
+		out, m := stringcases.FormatMap("userAPI_Key", snake)
+		assert.Equal("user_api_key", out)
+		assert.Equal([]stringcases.Mapping{
+			{Span: stringcases.Span{Word: "user", Start: 0, End: 4}, Source: stringcases.Span{Word: "user", Start: 0, End: 4}},
+			{Span: stringcases.Span{Word: "api", Start: 5, End: 8}, Source: stringcases.Span{Word: "API", Start: 4, End: 7}},
+			{Span: stringcases.Span{Word: "key", Start: 9, End: 12}, Source: stringcases.Span{Word: "Key", Start: 8, End: 11}},
+		}, m)
+	})
+
+	t.Run("offsets", func(t *testing.T) {
+		for _, in := range []string{"user_id", "naïve café", "HTTPServer2", "  leading space"} {
+			out, m := stringcases.FormatMap(in, pascal)
+			for _, w := range m {
+				assert.Equal(t, w.Word, out[w.Start:w.End], in)
+				assert.Equal(t, w.Source.Word, in[w.Source.Start:w.Source.End], in)
+			}
+		}
+	})
+
+	t.Run("underscores", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithUnderscores())
+		out, m := str.FormatMap("__userID__", pascal)
+		assert.Equal(t, "__UserID__", out)
+		assert.Equal(t, []stringcases.Mapping{
+			{Span: stringcases.Span{Word: "User", Start: 2, End: 6}, Source: stringcases.Span{Word: "user", Start: 2, End: 6}},
+			{Span: stringcases.Span{Word: "ID", Start: 6, End: 8}, Source: stringcases.Span{Word: "ID", Start: 6, End: 8}},
+		}, m)
+	})
+
+	t.Run("max words", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithMaxWords(1))
+		out, m := str.FormatMap("user_account", snake)
+		assert.Equal(t, "user", out)
+		assert.Len(t, m, 1)
+	})
+
+	t.Run("shortened", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithMaxLength(9, stringcases.AbbreviateWords))
+		out, m := str.FormatMap("userAccountSettings", snake)
+		assert.Equal(t, "usr_a_s", out)
+		assert.Nil(t, m)
+	})
+}
@@ -0,0 +1,67 @@
+package stringcases
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+var Strict = s.Strict
+
+// Strict wraps the converter so that it returns an error instead of silently
+// dropping content. The input is rejected with an *InputError wrapping
+// ErrInvalidUTF8 or ErrDisallowedRune, or with ErrEmpty when it has no words.
+// Invisible format and control characters, such as the zero-width space,
+// are always disallowed, although other converters remove them.
+// Invalid UTF-8 is only rejected with UTF8Error, the default UTF8Policy, and
+// input starting with an initialism is rejected with ErrLeadingInitialism
+// under LeadingError. Under AmbiguityError, output that splits into a
+// different number of words than the input is rejected with ErrAmbiguous,
+// e.g.
+//
+//	toSnake := stringcases.Strict(stringcases.ToSnake)
+//	toSnake("user\xffName") // "", ErrInvalidUTF8
+func (str *String) Strict(fn func(string) string) func(string) (string, error) {
+	return func(s string) (string, error) {
+		if err := str.validate(s); err != nil {
+			return "", err
+		}
+
+		out := fn(s)
+		if out == "" {
+			return "", ErrEmpty
+		}
+		n := len(str.tokenize(s))
+		if str.maxWords > 0 {
+			n = min(n, str.maxWords)
+		}
+		if str.ambiguity == AmbiguityError && len(str.tokenize(out)) != n {
+			return "", fmt.Errorf("%w: %q", ErrAmbiguous, out)
+		}
+
+		return out, nil
+	}
+}
+
+func (str *String) validate(s string) error {
+	var prev rune
+	for i, r := range s {
+		if r == utf8.RuneError && str.invalidUTF8 == UTF8Error {
+			if _, n := utf8.DecodeRuneInString(s[i:]); n <= 1 {
+				return &InputError{Offset: i, Rune: r, Err: ErrInvalidUTF8}
+			}
+		}
+
+		if str.invisible(prev, r) || str.disallowed != nil && str.disallowed(r) || str.symbols == SymbolError && str.isSymbol(r) {
+			return &InputError{Offset: i, Rune: r, Err: ErrDisallowedRune}
+		}
+		prev = r
+	}
+
+	if str.leading == LeadingError {
+		if tokens := str.tokenize(s); len(tokens) > 0 && str.isInitialism(str.upper(tokens[0])) {
+			return fmt.Errorf("%w: %q", ErrLeadingInitialism, tokens[0])
+		}
+	}
+
+	return nil
+}
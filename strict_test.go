@@ -0,0 +1,69 @@
+package stringcases_test
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestStrict(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		assert := assert.New(t)
+
+		got, err := stringcases.Strict(stringcases.ToSnake)("userAPI")
+		assert.NoError(err)
+		assert.Equal("user_api", got)
+	})
+
+	t.Run("invalid utf-8", func(t *testing.T) {
+		assert := assert.New(t)
+
+		_, err := stringcases.Strict(stringcases.ToSnake)("user\xffName")
+		assert.ErrorIs(err, stringcases.ErrInvalidUTF8)
+
+		var inputErr *stringcases.InputError
+		assert.ErrorAs(err, &inputErr)
+		assert.Equal(4, inputErr.Offset)
+	})
+
+	t.Run("replacement character is valid", func(t *testing.T) {
+		got, err := stringcases.Strict(stringcases.ToKebab)("user�Name")
+		assert.NoError(t, err)
+		assert.Equal(t, "user-name", got)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := stringcases.Strict(stringcases.ToCamel)("__--")
+		assert.ErrorIs(t, err, stringcases.ErrEmpty)
+	})
+
+	t.Run("disallowed", func(t *testing.T) {
+		assert := assert.New(t)
+
+		str := stringcases.New(language.English, stringcases.WithDisallowedRunes(func(r rune) bool {
+			return r == '@' || unicode.IsSymbol(r)
+		}))
+
+		_, err := str.Strict(str.ToSnake)("user@email")
+		assert.ErrorIs(err, stringcases.ErrDisallowedRune)
+		assert.EqualError(err, `stringcases: disallowed rune: '@' at byte 4`)
+
+		got, err := str.Strict(str.ToSnake)("user_email")
+		assert.NoError(err)
+		assert.Equal("user_email", got)
+	})
+	t.Run("invisible", func(t *testing.T) {
+		assert := assert.New(t)
+
+		_, err := stringcases.Strict(stringcases.ToSnake)("user\u200bName")
+		assert.ErrorIs(err, stringcases.ErrDisallowedRune)
+
+		var inputErr *stringcases.InputError
+		assert.ErrorAs(err, &inputErr)
+		assert.Equal(4, inputErr.Offset)
+		assert.Equal('\u200b', inputErr.Rune)
+	})
+}
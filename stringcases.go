@@ -3,19 +3,45 @@ package stringcases
 import (
 	"errors"
 	"io"
+	"iter"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
 )
 
 var (
-	s        = New(language.English)
-	ToKebab  = s.ToKebab
-	ToCamel  = s.ToCamel
-	ToSnake  = s.ToSnake
-	ToPascal = s.ToPascal
+	s          = New(language.English)
+	ToKebab    = s.ToKebab
+	ToCamel    = s.ToCamel
+	ToSnake    = s.ToSnake
+	ToPascal   = s.ToPascal
+	ToTitle    = s.ToTitle
+	ToSentence = s.ToSentence
+	ToHuman    = s.ToHuman
+	FromHuman  = s.FromHuman
+	ToHeader   = s.ToHeader
+	ToNoCase   = s.ToNoCase
+
+	ToScreamingSnake = s.ToScreamingSnake
+	ToCamelLower     = s.ToCamelLower
+	ToPascalStrict   = s.ToPascalStrict
+	ToInitials       = s.ToInitials
+	LowerFirst       = s.LowerFirst
+	UpperFirst       = s.UpperFirst
+
+	ToDelimited       = s.ToDelimited
+	ToDelimitedUpper  = s.ToDelimitedUpper
+	ValidateSeparator = s.ValidateSeparator
+	Tokenize          = s.Tokenize
+	Words             = s.Words
+	TokenizeSpans     = s.TokenizeSpans
+	WordCount         = s.WordCount
 )
 
 // https://github.com/golang/lint/blob/6edffad5e6160f5949cdefc81710b2706fbcd4f6/lint.go#LL766-L809
@@ -63,192 +89,1115 @@ var commonInitialisms = map[string]bool{
 	"XSS":   true,
 }
 
+// minorWords is the default set of words that are kept lowercase by ToTitle
+// unless they are the first or last word, following AP/Chicago style.
+var minorWords = map[string]bool{
+	"a":   true,
+	"an":  true,
+	"and": true,
+	"as":  true,
+	"at":  true,
+	"but": true,
+	"by":  true,
+	"for": true,
+	"in":  true,
+	"nor": true,
+	"of":  true,
+	"on":  true,
+	"or":  true,
+	"per": true,
+	"so":  true,
+	"the": true,
+	"to":  true,
+	"via": true,
+	"vs":  true,
+	"yet": true,
+}
+
+// InitialsOptions configures ToInitials.
+type InitialsOptions struct {
+	// MaxLength limits the number of runes in the result. Zero means no
+	// limit.
+	MaxLength int
+
+	// KeepDigits keeps numeric words in full, e.g. "Windows 95" becomes
+	// "W95" instead of "W".
+	KeepDigits bool
+}
+
 type String struct {
+	tag                             language.Tag
+	asciiCase                       bool
+	fastASCII                       bool
+	fastScan                        bool
 	uppercase, lowercase, titlecase cases.Caser
+	minorWords                      map[string]bool
+	ignoreInitialisms               bool
+	numbers                         NumberPolicy
+	versions                        bool
+	patterns                        bool
+	romans                          bool
+	graphemes                       bool
+	acronymBoundary                 AcronymBoundaryPolicy
+	delimiters                      bool
+	underscores                     bool
+	disallowed                      func(rune) bool
+	separators                      func(rune) bool
+	symbols                         SymbolPolicy
+	placeholder                     string
+	normalize                       bool
+	form                            norm.Form
+	diacritics                      bool
+	transliteration                 map[rune]string
+	cjk                             CJKPolicy
+	emoji                           EmojiPolicy
+	sharpS                          SharpSPolicy
+	invalidUTF8                     UTF8Policy
+	whitespace                      WhitespacePolicy
+	hungarian                       []string
+	trimPrefixes, trimSuffixes      []string
+	maxLength                       int
+	maxWords                        int
+	lengthStrategy                  LengthStrategy
+	hashLength                      int
+	tokenHook                       func(i int, token string) string
+	tokenizer                       Tokenizer
+	leading                         LeadingInitialismPolicy
+	acronyms                        bool
+	minimal                         bool
+	ambiguity                       AmbiguityPolicy
+	marker                          string
+	contractions                    ContractionPolicy
+
+	// abbreviations and expansions map lowercase words to the words that
+	// replace them.
+	abbreviations map[string]string
+	expansions    map[string]string
+
+	// contractionTable maps contractions to their expanded form, and
+	// contractionIndex maps them without apostrophes and in lowercase to
+	// their spelling in the table.
+	contractionTable map[string]string
+	contractionIndex map[string]string
+
+	// specialWords maps the lowercase form of mixed case words, such as
+	// "oauth", to their exact casing.
+	specialWords map[string]string
+
+	// initialisms is copied on write, so conversions can read it while
+	// AddInitialism and RemoveInitialism hold mu.
+	mu          sync.Mutex
+	initialisms atomic.Pointer[initialismSet]
 }
 
-func New(t language.Tag) *String {
-	return &String{
-		titlecase: cases.Title(t),
-		lowercase: cases.Lower(t),
-		uppercase: cases.Upper(t),
+// New returns an instance for the language with the options applied. It
+// panics when the options are invalid, see Validate.
+func New(t language.Tag, opts ...Option) *String {
+	str := newString(t, opts...)
+	if err := str.Validate(); err != nil {
+		panic(err)
 	}
+
+	return str
 }
 
-func (str *String) ToSnake(s string) string {
-	tokens := tokenize(s)
-	runes := make([]string, len(tokens))
-	for i, token := range tokens {
-		runes[i] = str.lowercase.String(token)
+func newString(t language.Tag, opts ...Option) *String {
+	str := &String{
+		tag:        t,
+		titlecase:  cases.Title(t),
+		lowercase:  cases.Lower(t),
+		uppercase:  cases.Upper(t),
+		minorWords: minorWords,
+	}
+	spellings := make(map[string]string, len(commonInitialisms))
+	for w := range commonInitialisms {
+		spellings[w] = w
 	}
+	str.initialisms.Store(newInitialismSet(spellings))
+	for _, opt := range opts {
+		opt(str)
+	}
+	str.fastASCII = str.asciiCase || asciiCasing(t)
+	str.fastScan = str.asciiScan()
 
-	return strings.Join(runes, "_")
+	return str
 }
 
-func (str *String) ToKebab(s string) string {
-	tokens := tokenize(s)
-	runes := make([]string, len(tokens))
-	for i, token := range tokens {
-		runes[i] = str.lowercase.String(token)
+// SetMinorWords replaces the words that ToTitle keeps lowercase when they are
+// neither the first nor the last word.
+func (str *String) SetMinorWords(words ...string) {
+	m := make(map[string]bool, len(words))
+	for _, w := range words {
+		m[str.lowercase.String(w)] = true
 	}
 
-	return strings.Join(runes, "-")
+	str.minorWords = m
 }
 
-func (str *String) ToCamel(s string) string {
-	tokens := tokenize(s)
-	runes := make([]string, len(tokens))
-	for i, token := range tokens {
-		if i == 0 {
-			runes[i] = str.lowercase.String(token)
-			continue
-		}
+func (str *String) ToSnake(s string) string {
+	return str.ToDelimited(s, "_")
+}
 
-		u := str.uppercase.String(token)
-		if commonInitialisms[u] {
-			runes[i] = u
-		} else {
-			runes[i] = str.titlecase.String(token)
+func (str *String) ToKebab(s string) string {
+	return str.ToDelimited(s, "-")
+}
+
+// ToScreamingSnake converts the string into uppercase snake case, e.g.
+// "userAPI" becomes "USER_API".
+func (str *String) ToScreamingSnake(s string) string {
+	return str.ToDelimitedUpper(s, "_")
+}
+
+// ToNoCase converts the string into lowercase words separated by a single
+// space, e.g. "UserAPI" becomes "user api". It is a neutral form useful for
+// search indexing and fuzzy matching.
+func (str *String) ToNoCase(s string) string {
+	return str.ToDelimited(s, " ")
+}
+
+// ToDelimited lowercases each word and joins them with sep, e.g.
+// ToDelimited("userAPI", ":") returns "user:api". The separator may have
+// several runes, such as "::" or "->", see ValidateSeparator.
+func (str *String) ToDelimited(s, sep string) string {
+	return str.format(s, Format{Separator: sep})
+}
+
+// ValidateSeparator reports whether sep can be told apart from the words it
+// joins. It returns an *InputError wrapping ErrInvalidSeparator for the first
+// rune of sep that could be part of a word, such as a letter, a number, or a
+// symbol kept by SymbolKeep, since the output could then not be split again.
+func (str *String) ValidateSeparator(sep string) error {
+	for i, r := range sep {
+		if !str.isSeparator(r) || str.symbols == SymbolReplace && str.isSymbol(r) || str.emoji == EmojiToken && isEmoji(r) {
+			return &InputError{Offset: i, Rune: r, Err: ErrInvalidSeparator}
 		}
 	}
 
-	return strings.Join(runes, "")
+	return nil
+}
+
+// ToDelimitedUpper uppercases each word and joins them with sep, e.g.
+// ToDelimitedUpper("userAPI", "_") returns "USER_API".
+func (str *String) ToDelimitedUpper(s, sep string) string {
+	return str.format(s, Format{Separator: sep, First: WordUpper, Rest: WordUpper})
+}
+
+func (str *String) ToCamel(s string) string {
+	return str.format(s, Format{First: WordLower, Rest: WordTitle, Initialisms: TitleInitialisms})
+}
+
+// ToCamelLower is like ToCamel, but titlecases initialisms instead of
+// uppercasing them, e.g. "user_api_url" becomes "userApiUrl", matching
+// JavaScript and JSON conventions.
+func (str *String) ToCamelLower(s string) string {
+	return str.format(s, Format{First: WordLower, Rest: WordTitle})
 }
 
 func (str *String) ToPascal(s string) string {
-	tokens := tokenize(s)
-	runes := make([]string, len(tokens))
-	for i, token := range tokens {
-		u := str.uppercase.String(token)
-		if commonInitialisms[u] {
-			runes[i] = u
-		} else {
-			runes[i] = str.titlecase.String(token)
+	return str.format(s, Format{First: WordTitle, Rest: WordTitle, Initialisms: TitleInitialisms})
+}
+
+// ToPascalStrict is like ToPascal, but titlecases initialisms instead of
+// uppercasing them, e.g. "HTTPServer" becomes "HttpServer", matching the
+// Google Java style.
+func (str *String) ToPascalStrict(s string) string {
+	return str.format(s, Format{First: WordTitle, Rest: WordTitle})
+}
+
+// ToHeader converts the string into an HTTP header key, e.g. "xRequestId"
+// becomes "X-Request-ID". The result matches textproto.CanonicalMIMEHeaderKey
+// case-insensitively, but keeps common initialisms uppercase.
+func (str *String) ToHeader(s string) string {
+	return str.format(s, Format{Separator: "-", First: WordTitle, Rest: WordTitle, Initialisms: TitleInitialisms})
+}
+
+// ToTitle converts the string into a human title, e.g. "the lord of the
+// rings" becomes "The Lord of the Rings". Minor words stay lowercase unless
+// they are the first or last word.
+func (str *String) ToTitle(s string) string {
+	return string(str.AppendTitle(nil, s))
+}
+
+// ToSentence converts the string into sentence form, e.g. "userAPIKey"
+// becomes "User API key". Only the first word is capitalized, and common
+// initialisms are kept uppercase.
+func (str *String) ToSentence(s string) string {
+	return str.format(s, Format{Separator: " ", First: WordTitle, Rest: WordLower, Initialisms: AllInitialisms})
+}
+
+// ToInitials builds an acronym from the first letter of every word, e.g.
+// "customer relationship management" becomes "CRM", and "HyperText Transfer
+// Protocol" becomes "HTTP". Compound words are not split, so "hypertext
+// transfer protocol" becomes "HTP".
+func (str *String) ToInitials(s string, opts InitialsOptions) string {
+	return string(str.AppendInitials(nil, s, opts))
+}
+
+// LowerFirst lowercases only the first word and leaves the rest of the
+// string untouched, e.g. "HTTPServer" becomes "httpServer".
+func (str *String) LowerFirst(s string) string {
+	return str.replaceFirst(s, func(token string) string {
+		return str.lower(token)
+	})
+}
+
+// UpperFirst capitalizes only the first word and leaves the rest of the
+// string untouched, e.g. "idNumber" becomes "IDNumber".
+func (str *String) UpperFirst(s string) string {
+	return str.replaceFirst(s, func(token string) string {
+		return str.caseWord(token, WordTitle, TitleInitialisms)
+	})
+}
+
+// replaceFirst replaces the first word of s with fn of it, and keeps the
+// rest of s as it is. The input is only trimmed to find the word, and not
+// rewritten otherwise, so the offsets of the word are exact. Placeholders
+// and the words of WithAbbreviations and WithExpansions are not in s, so fn
+// gets the word of s that they replace.
+func (str *String) replaceFirst(s string, fn func(string) string) string {
+	t, offset := str.trim(s)
+
+	start, end := -1, -1
+	str.scanFrom(t, offset, func(token string, i, j int, r rule) bool {
+		if token == "" || r == rulePlaceholder {
+			return true
 		}
+
+		start, end = i, j
+
+		return false
+	})
+	if start < 0 {
+		return s
 	}
 
-	return strings.Join(runes, "")
+	return s[:start] + fn(s[start:end]) + s[end:]
+}
+
+// ToHuman converts an identifier into human readable text, e.g.
+// "employee_salary" becomes "Employee salary". Like ToSentence, but a trailing
+// "id" word is dropped, so "author_id" becomes "Author". See WithContractions
+// to spell contractions such as "dont_retry".
+func (str *String) ToHuman(s string) string {
+	return string(str.AppendHuman(nil, s))
 }
 
-func tokenize(s string) []string {
+// FromHuman converts human readable text back into a snake case identifier,
+// e.g. "Employee salary" becomes "employee_salary". Apostrophes are removed
+// before tokenizing, so "User's name" becomes "users_name".
+func (str *String) FromHuman(s string) string {
+	return string(str.AppendFromHuman(nil, s))
+}
+
+// Tokenize splits the string into the words that the converters case and
+// join, e.g. "userAPIKey" becomes "user", "API" and "Key". The words keep
+// their original casing, and the options of the instance apply, so
+// WithDelimiterRuns adds empty words for runs of delimiters.
+func (str *String) Tokenize(s string) []string {
+	return str.tokenize(s)
+}
+
+// Words is like Tokenize, but streams the words instead of collecting them,
+// e.g.
+//
+//	for w := range str.Words("userAPIKey") {
+//		fmt.Println(w) // "user", "API", "Key"
+//	}
+func (str *String) Words(s string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		str.scan(s, func(token string, _, _ int, _ rule) bool {
+			return yield(token)
+		})
+	}
+}
+
+// WordCount returns the number of words in the string without collecting
+// them, e.g. "userAPIKey" has 3 words. The empty words of WithDelimiterRuns
+// are not counted.
+func (str *String) WordCount(s string) int {
+	var n int
+	str.scan(s, func(token string, _, _ int, _ rule) bool {
+		if token != "" {
+			n++
+		}
+
+		return true
+	})
+
+	return n
+}
+
+// Span is a word of the input with its byte offsets.
+type Span struct {
+	Word       string
+	Start, End int
+}
+
+// TokenizeSpans is like Tokenize, but also returns where every word is in s,
+// e.g. "userID" has "user" at [0, 4) and "ID" at [4, 6). The offsets are
+// exact unless options rewrite the input before it is tokenized, such as
+// WithNormalization, WithStripDiacritics, WithTransliteration and
+// WhitespaceIgnore, or the input is invalid UTF-8 or has invisible
+// characters such as the zero-width space.
+func (str *String) TokenizeSpans(s string) []Span {
+	var spans []Span
+	str.scan(s, func(token string, start, end int, _ rule) bool {
+		spans = append(spans, Span{Word: token, Start: start, End: end})
+
+		return true
+	})
+
+	return spans
+}
+
+// Tokenizer splits a string into words.
+type Tokenizer interface {
+	Tokenize(s string) []string
+}
+
+// TokenizerFunc adapts a function to a Tokenizer.
+type TokenizerFunc func(s string) []string
+
+func (f TokenizerFunc) Tokenize(s string) []string {
+	return f(s)
+}
+
+func (str *String) tokenize(s string) []string {
 	var tokens []string
+	str.scan(s, func(token string, _, _ int, _ rule) bool {
+		tokens = append(tokens, token)
+
+		return true
+	})
+
+	return tokens
+}
+
+// scan calls yield with every word of s and its byte offsets in s until
+// yield returns false. Words from WithExpansions share the offsets of the
+// abbreviation they replace, and the empty words of WithDelimiterRuns are
+// placed at the start of the next word. The rule tells how the word was
+// found, see ExplainTokenize.
+func (str *String) scan(src string, yield func(token string, start, end int, r rule) bool) {
+	s, offset := str.prepare(src)
+	str.scanFrom(s, offset, yield)
+}
+
+// scanFrom is like scan, but tokenizes s as it is, and adds offset to the
+// offsets of the words.
+func (str *String) scanFrom(s string, offset int, yield func(token string, start, end int, r rule) bool) {
+	var inserted []int
+	switch {
+	case str.numbers == NumberUnits && str.patterns:
+		s, inserted = splitNumbers(s, func(s string) int {
+			return max(matchUnit(s), matchNumericPattern(s))
+		})
+	case str.numbers == NumberUnits:
+		s, inserted = splitNumbers(s, matchUnit)
+	case str.patterns:
+		s, inserted = splitNumbers(s, matchNumericPattern)
+	}
+
+	// at maps an offset in s back to the input, which is only exact when s
+	// was not rewritten beyond trimming its prefix and splitting numbers.
+	at := func(i int) int {
+		n := offset + i
+		for _, j := range inserted {
+			if j < i {
+				n--
+			}
+		}
+
+		return n
+	}
+
+	// gap counts the separators since the last token.
+	var gap int
+	var emitted bool
+	emit := func(token string, start, end int, r rule) bool {
+		start, end = at(start), at(end)
+		if str.delimiters && emitted {
+			for ; gap > 1; gap-- {
+				if !yield("", start, start, ruleDelimiters) {
+					return false
+				}
+			}
+		}
+
+		gap = 0
+		emitted = true
+		if w, ok := str.expansions[strings.ToLower(token)]; ok {
+			for _, f := range strings.Fields(w) {
+				if !yield(f, start, end, ruleExpansion) {
+					return false
+				}
+			}
+
+			return true
+		}
+		if w, ok := str.abbreviations[strings.ToLower(token)]; ok {
+			return yield(w, start, end, ruleAbbreviation)
+		}
+
+		return yield(token, start, end, r)
+	}
+
+	if str.tokenizer != nil {
+		// Custom tokenizers do not report offsets, so the words are looked up
+		// in order.
+		var end int
+		for _, token := range str.tokenizer.Tokenize(s) {
+			start := end
+			if i := strings.Index(s[end:], token); i >= 0 {
+				start, end = end+i, end+i+len(token)
+			}
+			if !emit(token, start, end, ruleTokenizer) {
+				return
+			}
+		}
+
+		return
+	}
 
 	reader := strings.NewReader(s)
+	pos := func() int {
+		return len(s) - reader.Len()
+	}
 	for {
+		start := pos()
+
+		// Special words, mixed initialisms and versions are matched first,
+		// since their mixed casing would otherwise split them, e.g. "OAuth"
+		// into "OA" and "uth".
+		if n, r := str.matchWord(s[start:]); n > 0 {
+			if _, err := reader.Seek(int64(n), io.SeekCurrent); err != nil {
+				panic(err)
+			}
+			if !emit(s[start:start+n], start, start+n, r) {
+				return
+			}
+
+			continue
+		}
+
 		r, _, err := reader.ReadRune()
 		if errors.Is(err, io.EOF) {
 			break
 		}
 
+		var token string
+		var rl rule
 		switch {
-		case unicode.IsNumber(r), unicode.IsLower(r):
-			token := extractLower(reader, []rune{r})
-			tokens = append(tokens, token)
+		case str.isNumber(r), str.isLower(r):
+			token = str.extractLower(reader, []rune{r})
+			rl = ruleLower
+			if str.isNumber(r) {
+				rl = ruleNumber
+			}
+
+		case str.isUpper(r):
+			token = str.extractUpper(reader, []rune{r})
+			rl = str.upperRule(token)
 
-		case unicode.IsUpper(r):
-			token := extractUpper(reader, []rune{r})
-			tokens = append(tokens, token)
+		case str.cjk != CJKDrop && isCJK(r):
+			token = str.extractCJK(reader, []rune{r})
+			rl = ruleCJK
+
+		case str.emoji == EmojiToken && isEmoji(r):
+			token = string(str.extendGrapheme(reader, []rune(extractEmoji(reader, r))))
+			rl = ruleEmoji
+
+		case str.symbols == SymbolReplace && str.isSymbol(r):
+			// A run of symbols becomes a single placeholder word.
+			token = str.placeholder
+			rl = rulePlaceholder
+			for {
+				r, _, err := reader.ReadRune()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+
+				if !str.isSymbol(r) {
+					if err := reader.UnreadRune(); err != nil {
+						panic(err)
+					}
+
+					break
+				}
+			}
 
 		default:
-			// Skip non-alphanumeric runes.
+			// Skip non-alphanumeric runes, and the rest of their grapheme
+			// cluster.
+			str.extendGrapheme(reader, []rune{r})
+			gap++
+
+			continue
+		}
+
+		if !emit(token, start, pos(), rl) {
+			return
 		}
 	}
+}
 
-	return tokens
+// matchWord returns the byte length of the special word, initialism with
+// digits or lowercase letters, version, numeric pattern or number with a unit
+// that s starts with, or zero, and the rule that matched it.
+func (str *String) matchWord(s string) (int, rule) {
+	if n := matchLongest(s, str.specialWords); n > 0 {
+		return n, ruleSpecialWord
+	}
+	if n := matchLongest(s, str.initialisms.Load().mixed); n > 0 {
+		return n, ruleInitialism
+	}
+
+	if str.versions {
+		if n := matchVersion(s); n > 0 {
+			return n, ruleVersion
+		}
+	}
+	if str.patterns {
+		if n := matchNumericPattern(s); n > 0 {
+			return n, ruleNumericPattern
+		}
+	}
+	if str.numbers == NumberUnits {
+		if n := matchUnit(s); n > 0 {
+			return n, ruleUnit
+		}
+	}
+
+	return 0, ruleNone
+}
+
+// matchNumericPattern returns the byte length of the UUID, hexadecimal
+// literal or date that s starts with, or zero.
+func matchNumericPattern(s string) int {
+	if n := matchUUID(s); n > 0 {
+		return n
+	}
+	if n := matchHex(s); n > 0 {
+		return n
+	}
+
+	return matchDate(s)
+}
+
+// matchUUID returns the byte length of the UUID that s starts with, such as
+// "550e8400-e29b-41d4-a716-446655440000", or zero.
+func matchUUID(s string) int {
+	const n = 36
+	if len(s) < n {
+		return 0
+	}
+
+	for i := range n {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return 0
+			}
+		default:
+			if !isHex(s[i]) {
+				return 0
+			}
+		}
+	}
+
+	if r, _ := utf8.DecodeRuneInString(s[n:]); unicode.IsLetter(r) || unicode.IsNumber(r) {
+		return 0
+	}
+
+	return n
+}
+
+// matchHex returns the byte length of the hexadecimal literal that s starts
+// with, such as "0xFF", or zero. The literal must not be followed by a
+// lowercase letter or number, so "0xFFColor" matches "0xFF".
+func matchHex(s string) int {
+	if len(s) < 3 || s[0] != '0' || s[1] != 'x' && s[1] != 'X' {
+		return 0
+	}
+
+	n := 2
+	for n < len(s) && isHex(s[n]) {
+		n++
+	}
+
+	for ; n > 2; n-- {
+		if r, _ := utf8.DecodeRuneInString(s[n:]); !unicode.IsLower(r) && !unicode.IsNumber(r) {
+			return n
+		}
+	}
+
+	return 0
+}
+
+// matchDate returns the byte length of the date or timestamp that s starts
+// with, such as "20240101" or "20240101120000", or zero. The date must not be
+// followed by a lowercase letter or number.
+func matchDate(s string) int {
+	n := digits(s)
+	if n != 8 && n != 14 {
+		return 0
+	}
+	if r, _ := utf8.DecodeRuneInString(s[n:]); unicode.IsLower(r) || unicode.IsNumber(r) {
+		return 0
+	}
+
+	field := func(i int) int {
+		return int(s[i]-'0')*10 + int(s[i+1]-'0')
+	}
+	if century := field(0); century != 19 && century != 20 {
+		return 0
+	}
+	if month, day := field(4), field(6); month < 1 || month > 12 || day < 1 || day > 31 {
+		return 0
+	}
+	if n == 14 && (field(8) > 23 || field(10) > 59 || field(12) > 59) {
+		return 0
+	}
+
+	return n
+}
+
+func isHex(b byte) bool {
+	return '0' <= b && b <= '9' || 'a' <= b && b <= 'f' || 'A' <= b && b <= 'F'
+}
+
+// matchVersion returns the byte length of the version that s starts with,
+// such as "v2", "V10" or "v1beta1", or zero. The version must not be followed
+// by a letter or number.
+func matchVersion(s string) int {
+	if s == "" || s[0] != 'v' && s[0] != 'V' {
+		return 0
+	}
+
+	n := 1 + digits(s[1:])
+	if n == 1 {
+		return 0
+	}
+
+	for _, pre := range []string{"alpha", "beta", "rc"} {
+		if len(s[n:]) >= len(pre) && strings.EqualFold(s[n:n+len(pre)], pre) {
+			n += len(pre)
+			n += digits(s[n:])
+
+			break
+		}
+	}
+
+	if r, _ := utf8.DecodeRuneInString(s[n:]); unicode.IsLower(r) || unicode.IsNumber(r) {
+		return 0
+	}
+
+	return n
 }
 
-func extractUpper(reader *strings.Reader, runes []rune) string {
+// units are the unit and ordinal suffixes kept with the number before them by
+// NumberUnits, longest first.
+var units = []string{
+	"kbps", "mbps", "gbps",
+	"bps", "kib", "mib", "gib", "tib", "khz", "mhz", "ghz", "rem",
+	"kb", "mb", "gb", "tb", "pb", "ns", "us", "ms", "hz", "px", "em", "pt",
+	"st", "nd", "rd", "th",
+	"b", "s", "m", "h", "d", "k", "x",
+}
+
+// matchUnit returns the byte length of the number with a unit or ordinal
+// suffix that s starts with, such as "10MB" or "3rd", or zero. The suffix
+// must not be followed by a lowercase letter or number.
+func matchUnit(s string) int {
+	n := digits(s)
+	if n == 0 {
+		return 0
+	}
+
+	for _, u := range units {
+		if len(s[n:]) < len(u) || !strings.EqualFold(s[n:n+len(u)], u) {
+			continue
+		}
+
+		if r, _ := utf8.DecodeRuneInString(s[n+len(u):]); !unicode.IsLower(r) && !unicode.IsNumber(r) {
+			return n + len(u)
+		}
+	}
+
+	return 0
+}
+
+// splitNumbers separates the numbers that match from the letters before
+// them, e.g. "max10MB" becomes "max 10MB" with matchUnit. It also returns the
+// offsets of the inserted spaces.
+func splitNumbers(s string, match func(string) int) (string, []int) {
+	var sb strings.Builder
+	var inserted []int
+	var prev rune
+	for i, r := range s {
+		if unicode.IsLetter(prev) && '0' <= r && r <= '9' && match(s[i:]) > 0 {
+			inserted = append(inserted, sb.Len())
+			sb.WriteByte(' ')
+		}
+		sb.WriteRune(r)
+		prev = r
+	}
+
+	return sb.String(), inserted
+}
+
+// digits returns the number of leading ASCII digits in s.
+func digits(s string) int {
+	var n int
+	for n < len(s) && '0' <= s[n] && s[n] <= '9' {
+		n++
+	}
+
+	return n
+}
+
+func (str *String) extractUpper(reader *strings.Reader, runes []rune) string {
 	for {
+		runes = str.extendGrapheme(reader, runes)
 		r, _, err := reader.ReadRune()
 		if errors.Is(err, io.EOF) {
 			return string(runes)
 		}
 
 		switch {
-		case unicode.IsUpper(r):
+		case str.isUpper(r):
 			// Continuous upper unicode indicates the possibility of common
 			// initialism word.
-			return extractCommonInitialism(reader, append(runes, r))
-		case unicode.IsLower(r), unicode.IsNumber(r):
+			return str.extractCommonInitialism(reader, append(runes, r))
+		case str.isNumber(r) && str.splitNumber(runes, r):
+			if err := reader.UnreadRune(); err != nil {
+				panic(err)
+			}
+
+			return string(runes)
+		case str.isLower(r), str.isNumber(r):
 			// Otherwise, it will be camel case word.
-			return extractCamel(reader, append(runes, r))
+			return str.extractCamel(reader, append(runes, r))
 		default:
 			// Word breaks when it is non-alphanumeric.
+			if err := reader.UnreadRune(); err != nil {
+				panic(err)
+			}
+
 			return string(runes)
 		}
 	}
 }
 
-func extractLower(reader *strings.Reader, runes []rune) string {
+func (str *String) extractLower(reader *strings.Reader, runes []rune) string {
 	for {
+		runes = str.extendGrapheme(reader, runes)
 		r, _, err := reader.ReadRune()
 		if errors.Is(err, io.EOF) {
 			return string(runes)
 		}
 
 		switch {
-		case unicode.IsUpper(r):
-			// Word breaks when the next character is upper.
+		case str.isUpper(r), str.splitNumber(runes, r):
+			// Word breaks when the next character is upper, or between
+			// letters and numbers depending on the number policy.
 			if err := reader.UnreadRune(); err != nil {
 				panic(err)
 			}
 
 			return string(runes)
-		case unicode.IsLower(r), unicode.IsNumber(r):
+		case str.isLower(r), str.isNumber(r):
 			runes = append(runes, r)
 		default:
 			// Word breaks when it is non-alphanumeric.
+			if err := reader.UnreadRune(); err != nil {
+				panic(err)
+			}
+
 			return string(runes)
 		}
 	}
 }
 
-func extractCommonInitialism(reader *strings.Reader, runes []rune) string {
+// extractCommonInitialism continues a run of uppercase letters. The run is
+// split into the longest initialisms at its start, so "HTTPSServer" splits
+// into "HTTPS" and "Server", not "HTTP" and "SServer", and "HTTPAPIKey" into
+// "HTTP", "API" and "Key". When a lowercase letter follows the run, the
+// initialisms that leave its last uppercase letter to start the next word
+// are preferred, and a plural "s" is kept, see AcronymBoundaryPolicy.
+func (str *String) extractCommonInitialism(reader *strings.Reader, runes []rune) string {
+	start := reader.Size() - int64(reader.Len()) - int64(len(string(runes)))
+	for {
+		runes = str.extendGrapheme(reader, runes)
+		r, _, err := reader.ReadRune()
+		if err != nil {
+			break
+		}
+		if !str.isUpper(r) {
+			if err := reader.UnreadRune(); err != nil {
+				panic(err)
+			}
+
+			break
+		}
+		runes = append(runes, r)
+	}
+
+	next, n, _ := reader.ReadRune()
+	after, _, _ := reader.ReadRune()
+
+	set := str.initialisms.Load()
+	word := runes
+	switch {
+	case next == 's' && !str.isLower(after) && !str.isNumber(after) && set.endsWithInitialism(runes):
+		if m := set.longestPrefix(runes); m == len(runes) {
+			// Plural initialisms keep their "s", e.g. "IDs".
+			seek(reader, start+int64(len(string(runes))+n))
+
+			return string(runes) + "s"
+		}
+	case str.isLower(next) && str.acronymBoundary == AcronymBoundaryLastUpper:
+		word = runes[:clusterStart(runes)]
+	case str.isLower(next) && set.longestPrefix(runes[:clusterStart(runes)]) > 0:
+		// Initialisms that leave the last uppercase letter to start the
+		// next word come first, e.g. "HTTPServer" splits into "HTTP" and
+		// "Server", not "HTTPS" and "erver".
+		word = runes[:clusterStart(runes)]
+	case str.isLower(next) && set.longestPrefix(runes) > 0:
+		// Otherwise the initialisms are kept whole, e.g. "APIkey" splits
+		// into "API" and "key".
+	case str.isLower(next) && str.acronyms && len(runes) > 2:
+		// Unknown acronyms end before the last uppercase letter, e.g.
+		// "NASAProgram" splits into "NASA" and "Program".
+		word = runes[:clusterStart(runes)]
+	case str.isLower(next) && str.romans && len(runes) > 2 && isRomanNumeral(string(runes[:clusterStart(runes)])):
+		// Roman numerals end before a capitalised word, e.g.
+		// "HenryVIIIPortrait" splits into "Henry", "VIII" and "Portrait".
+		word = runes[:clusterStart(runes)]
+	}
+
+	if m := set.longestPrefix(word); m > 0 && m < len(word) && !isGraphemeExtend(word[m]) {
+		word = word[:m]
+	}
+
+	seek(reader, start+int64(len(string(word))))
+	if len(word) == len(runes) {
+		return str.extractNumberSuffix(reader, word)
+	}
+
+	return string(word)
+}
+
+// seek moves the reader to the byte offset.
+func seek(reader *strings.Reader, offset int64) {
+	if _, err := reader.Seek(offset, io.SeekStart); err != nil {
+		panic(err)
+	}
+}
+
+func (str *String) extractCamel(reader *strings.Reader, runes []rune) string {
 	for {
+		runes = str.extendGrapheme(reader, runes)
 		r, _, err := reader.ReadRune()
 		if errors.Is(err, io.EOF) {
 			return string(runes)
 		}
 
 		switch {
-		case unicode.IsUpper(r):
-			runes = append(runes, r)
-			// Common initialism at present has length between 2 and 5.
-			if len(runes) >= 2 && len(runes) <= 5 {
-				if commonInitialisms[string(runes)] {
-					return string(runes)
-				}
-			}
-		// Common initialism pattern breaks at the next lower or number.
-		case unicode.IsLower(r), unicode.IsNumber(r):
+		case str.isUpper(r), str.splitNumber(runes, r):
 			if err := reader.UnreadRune(); err != nil {
 				panic(err)
 			}
+
 			return string(runes)
+		case str.isLower(r), str.isNumber(r):
+			runes = append(runes, r)
 		default:
+			if err := reader.UnreadRune(); err != nil {
+				panic(err)
+			}
+
 			return string(runes)
 		}
 	}
 }
 
-func extractCamel(reader *strings.Reader, runes []rune) string {
+// extractCJK continues a run of CJK runes. With CJKSegment, the run breaks
+// where the script changes, e.g. between "日本語" and "テキスト".
+func (str *String) extractCJK(reader *strings.Reader, runes []rune) string {
 	for {
+		runes = str.extendGrapheme(reader, runes)
 		r, _, err := reader.ReadRune()
 		if errors.Is(err, io.EOF) {
 			return string(runes)
 		}
 
-		switch {
-		case unicode.IsUpper(r):
+		if !isCJK(r) || str.cjk == CJKSegment && cjkScript(r) != cjkScript(runes[len(runes)-1]) {
 			if err := reader.UnreadRune(); err != nil {
 				panic(err)
 			}
 
 			return string(runes)
-		case unicode.IsLower(r), unicode.IsNumber(r):
-			runes = append(runes, r)
-		default:
+		}
+
+		runes = append(runes, r)
+	}
+}
+
+// extractNumberSuffix continues the matched initialism with the number that
+// follows it, if the number policy glues them, e.g. "HTTP2".
+func (str *String) extractNumberSuffix(reader *strings.Reader, runes []rune) string {
+	r, _, err := reader.ReadRune()
+	if errors.Is(err, io.EOF) {
+		return string(runes)
+	}
+
+	if str.isNumber(r) && !str.splitNumber(runes, r) {
+		return str.extractCamel(reader, append(runes, r))
+	}
+
+	if err := reader.UnreadRune(); err != nil {
+		panic(err)
+	}
+
+	return string(runes)
+}
+
+// splitNumber reports whether the word breaks before the next rune, when one
+// of the last rune of the word and the next rune is a number and the other is
+// a letter.
+func (str *String) splitNumber(word []rune, next rune) bool {
+	last := word[len(word)-1]
+	if str.isNumber(last) == str.isNumber(next) || !unicode.IsLetter(last) && !unicode.IsLetter(next) {
+		return false
+	}
+
+	switch str.numbers {
+	case NumberSplit:
+		return true
+	case NumberGlue:
+		return false
+	case NumberGlueInitialism:
+		return str.isNumber(next) && !str.isInitialism(string(word))
+	default:
+		// Numbers glue to words, except after a run of uppercase letters,
+		// e.g. "i18n" and "V2", but "HTTP" and "2".
+		return str.isNumber(next) && len(word) > 1 && isUpper(word)
+	}
+}
+
+// isUpper, isLower and isNumber classify the runes of a word. Runes that
+// are none of them are separators.
+func (str *String) isUpper(r rune) bool {
+	return unicode.IsUpper(r) && !str.isSeparator(r)
+}
+
+func (str *String) isLower(r rune) bool {
+	if str.separators == nil {
+		return unicode.IsLower(r) || str.symbols == SymbolKeep && str.isSymbol(r)
+	}
+
+	// Custom separators make every other rune part of a word, and caseless
+	// runes such as "." behave like lowercase ones.
+	return !unicode.IsUpper(r) && !unicode.IsNumber(r) && !str.separators(r)
+}
+
+func (str *String) isNumber(r rune) bool {
+	return unicode.IsNumber(r) && !str.isSeparator(r)
+}
+
+func (str *String) isSeparator(r rune) bool {
+	if str.separators != nil {
+		return str.separators(r)
+	}
+
+	// Whitespace always separates, even when symbols are kept.
+	if unicode.IsSpace(r) {
+		return true
+	}
+
+	return !unicode.IsUpper(r) && !unicode.IsLower(r) && !unicode.IsNumber(r) &&
+		!(str.symbols == SymbolKeep && str.isSymbol(r))
+}
+
+// isSymbol reports whether the SymbolPolicy applies to r, which leaves out
+// emoji unless they are handled like symbols, see EmojiSymbol.
+func (str *String) isSymbol(r rune) bool {
+	if str.emoji != EmojiSymbol && isEmoji(r) {
+		return false
+	}
+
+	return isSymbol(r)
+}
+
+// isSymbol reports whether r is punctuation or a symbol other than the usual
+// word separators, which are whitespace, "_" and "-".
+func isSymbol(r rune) bool {
+	if r == '_' || r == '-' {
+		return false
+	}
+
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// isEmoji reports whether r is in one of the blocks that hold emoji, which
+// also include a few symbols that are rarely drawn as emoji, such as "♠".
+func isEmoji(r rune) bool {
+	return 0x1f000 <= r && r <= 0x1faff ||
+		0x2600 <= r && r <= 0x27bf ||
+		0x2300 <= r && r <= 0x23ff ||
+		0x2b00 <= r && r <= 0x2bff
+}
+
+// extractEmoji returns the emoji r together with the variation selector and
+// skin tone modifiers that follow it.
+func extractEmoji(reader *strings.Reader, r rune) string {
+	runes := []rune{r}
+	for {
+		r, _, err := reader.ReadRune()
+		if errors.Is(err, io.EOF) {
+			return string(runes)
+		}
+
+		if r != '\ufe0f' && (r < 0x1f3fb || r > 0x1f3ff) {
+			if err := reader.UnreadRune(); err != nil {
+				panic(err)
+			}
+
 			return string(runes)
 		}
+
+		runes = append(runes, r)
+	}
+}
+
+// isCJK reports whether r is a Han, Hiragana, Katakana or Hangul rune, or
+// the Katakana prolonged sound mark "ー".
+func isCJK(r rune) bool {
+	return cjkScript(r) != nil
+}
+
+func cjkScript(r rune) *unicode.RangeTable {
+	switch {
+	case unicode.Is(unicode.Han, r):
+		return unicode.Han
+	case unicode.Is(unicode.Hiragana, r):
+		return unicode.Hiragana
+	case unicode.Is(unicode.Katakana, r), r == 'ー':
+		return unicode.Katakana
+	case unicode.Is(unicode.Hangul, r):
+		return unicode.Hangul
+	default:
+		return nil
+	}
+}
+
+func isUpper(runes []rune) bool {
+	for _, r := range runes {
+		if !unicode.IsUpper(r) {
+			return false
+		}
 	}
+
+	return true
 }
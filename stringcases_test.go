@@ -1,10 +1,16 @@
 package stringcases_test
 
 import (
+	"math/rand/v2"
+	"net/textproto"
+	"strings"
 	"testing"
 
 	"github.com/alextanhongpin/stringcases"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
 )
 
 func TestStringCase(t *testing.T) {
@@ -89,6 +95,13 @@ func TestStringCaseCommonInitialism(t *testing.T) {
 		{"version", "userAPIV2", "user-api-v2", "user_api_v2", "userAPIV2", "UserAPIV2"},
 		{"number", "netHTTP2", "net-http-2", "net_http_2", "netHTTP2", "NetHTTP2"},
 		{"end", "emailSMTP", "email-smtp", "email_smtp", "emailSMTP", "EmailSMTP"},
+		{"overlapping", "HTTPSServer", "https-server", "https_server", "httpsServer", "HTTPSServer"},
+		{"consecutive", "HTTPAPIKey", "http-api-key", "http_api_key", "httpAPIKey", "HTTPAPIKey"},
+		{"before lowercase", "APIkey", "api-key", "api_key", "apiKey", "APIKey"},
+		{"plural", "userIDs", "user-ids", "user_ids", "userIDs", "UserIDs"},
+		{"plural middle", "listAPIsNow", "list-apis-now", "list_apis_now", "listAPIsNow", "ListAPIsNow"},
+		{"plural prefix", "URLsToFetch", "urls-to-fetch", "urls_to_fetch", "urlsToFetch", "URLsToFetch"},
+		{"not plural", "IDsa", "id-sa", "id_sa", "idSa", "IDSa"},
 		{"random", "i18n", "i18n", "i18n", "i18n", "I18n"},
 		{"normal", "hello", "hello", "hello", "hello", "Hello"},
 		{"space", "hello world", "hello-world", "hello_world", "helloWorld", "HelloWorld"},
@@ -125,3 +138,591 @@ func TestStringCaseCommonInitialism(t *testing.T) {
 		})
 	}
 }
+
+func TestToCamelLower(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		want     string
+	}{
+		{"suffix", "user_id", "userId"},
+		{"prefix", "APIURL", "apiUrl"},
+		{"middle", "getHTTPResponse", "getHttpResponse"},
+		{"kebab", "json-web-token", "jsonWebToken"},
+		{"empty", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToCamelLower(test.text))
+		})
+	}
+}
+
+func TestToPascalStrict(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		want     string
+	}{
+		{"prefix", "HTTPServer", "HttpServer"},
+		{"snake", "xml_parser", "XmlParser"},
+		{"suffix", "userID", "UserId"},
+		{"version", "userAPIV2", "UserApiV2"},
+		{"empty", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToPascalStrict(test.text))
+		})
+	}
+}
+
+func TestToTitle(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		want     string
+	}{
+		{"words", "the quick brown fox", "The Quick Brown Fox"},
+		{"minor words", "the lord of the rings", "The Lord of the Rings"},
+		{"last minor word", "what are you looking at", "What Are You Looking At"},
+		{"camel", "aTaleOfTwoCities", "A Tale of Two Cities"},
+		{"initialism", "user_api_for_json", "User API for JSON"},
+		{"empty", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToTitle(test.text))
+		})
+	}
+
+	t.Run("custom minor words", func(t *testing.T) {
+		str := stringcases.New(language.English)
+		str.SetMinorWords("with", "the")
+
+		assert.Equal(t, "Gone with the Wind", str.ToTitle("gone with the wind"))
+		assert.Equal(t, "War And Peace", str.ToTitle("war and peace"))
+	})
+}
+
+func TestToSentence(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		want     string
+	}{
+		{"camel", "userAPIKey", "User API key"},
+		{"snake", "employee_salary", "Employee salary"},
+		{"leading initialism", "idNumber", "ID number"},
+		{"pascal", "FieldIsRequired", "Field is required"},
+		{"empty", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToSentence(test.text))
+		})
+	}
+}
+
+func TestToHuman(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		human    string
+		ident    string
+	}{
+		{"snake", "employee_salary", "Employee salary", "employee_salary"},
+		{"camel", "userAPIKey", "User API key", "user_api_key"},
+		{"trailing id", "author_id", "Author", "author"},
+		{"only id", "id", "ID", "id"},
+		{"empty", "", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert := assert.New(t)
+
+			h := stringcases.ToHuman(test.text)
+			assert.Equal(test.human, h)
+			assert.Equal(test.ident, stringcases.FromHuman(h))
+		})
+	}
+
+	t.Run("apostrophe", func(t *testing.T) {
+		assert.Equal(t, "users_name", stringcases.FromHuman("User's name"))
+		assert.Equal(t, "dont_retry", stringcases.FromHuman("Don’t retry"))
+	})
+}
+
+func TestToHeader(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		want     string
+	}{
+		{"kebab", "content-type", "Content-Type"},
+		{"camel", "xRequestId", "X-Request-ID"},
+		{"snake", "accept_encoding", "Accept-Encoding"},
+		{"canonical", "X-Forwarded-For", "X-Forwarded-For"},
+		{"empty", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert := assert.New(t)
+
+			h := stringcases.ToHeader(test.text)
+			assert.Equal(test.want, h)
+			assert.True(strings.EqualFold(textproto.CanonicalMIMEHeaderKey(h), h))
+		})
+	}
+}
+
+func TestToNoCase(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		want     string
+	}{
+		{"pascal", "UserAPI", "user api"},
+		{"snake", "user__api_key", "user api key"},
+		{"spaces", "  Hello   World ", "hello world"},
+		{"empty", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToNoCase(test.text))
+		})
+	}
+}
+
+func TestToDelimited(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		sep      string
+		lower    string
+		upper    string
+	}{
+		{"colon", "userAPIKey", ":", "user:api:key", "USER:API:KEY"},
+		{"pipe", "user_id", "|", "user|id", "USER|ID"},
+		{"space", "HelloWorld", " ", "hello world", "HELLO WORLD"},
+		{"empty separator", "hello-world", "", "helloworld", "HELLOWORLD"},
+		{"scope", "stdVectorIterator", "::", "std::vector::iterator", "STD::VECTOR::ITERATOR"},
+		{"arrow", "fromUserToGroup", "->", "from->user->to->group", "FROM->USER->TO->GROUP"},
+		{"path", "api_v2_users", "/", "api/v2/users", "API/V2/USERS"},
+		{"empty", "", ".", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(test.lower, stringcases.ToDelimited(test.text, test.sep))
+			assert.Equal(test.upper, stringcases.ToDelimitedUpper(test.text, test.sep))
+		})
+	}
+}
+
+func TestValidateSeparator(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		for _, sep := range []string{"", "_", "::", "->", "/", " | "} {
+			assert.NoError(t, stringcases.ValidateSeparator(sep), sep)
+		}
+	})
+
+	t.Run("part of words", func(t *testing.T) {
+		assert := assert.New(t)
+
+		err := stringcases.ValidateSeparator("_x_")
+		assert.ErrorIs(err, stringcases.ErrInvalidSeparator)
+		assert.EqualError(err, `stringcases: invalid separator: 'x' at byte 1`)
+
+		assert.ErrorIs(stringcases.ValidateSeparator("2"), stringcases.ErrInvalidSeparator)
+	})
+
+	t.Run("kept symbols", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithSymbolPolicy(stringcases.SymbolKeep))
+		assert.ErrorIs(t, str.ValidateSeparator("::"), stringcases.ErrInvalidSeparator)
+		assert.NoError(t, str.ValidateSeparator("-"))
+	})
+}
+
+func TestToInitials(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		opts     stringcases.InitialsOptions
+		want     string
+	}{
+		{"words", "customer relationship management", stringcases.InitialsOptions{}, "CRM"},
+		{"camel words", "HyperText Transfer Protocol", stringcases.InitialsOptions{}, "HTTP"},
+		{"compound word", "hypertext transfer protocol", stringcases.InitialsOptions{}, "HTP"},
+		{"snake", "as_soon_as_possible", stringcases.InitialsOptions{}, "ASAP"},
+		{"drop digits", "Windows 95 Edition", stringcases.InitialsOptions{}, "WE"},
+		{"keep digits", "Windows 95 Edition", stringcases.InitialsOptions{KeepDigits: true}, "W95E"},
+		{"max length", "as soon as possible", stringcases.InitialsOptions{MaxLength: 2}, "AS"},
+		{"empty", "", stringcases.InitialsOptions{}, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToInitials(test.text, test.opts))
+		})
+	}
+}
+
+func TestFirst(t *testing.T) {
+	tests := []struct {
+		scenario string
+		text     string
+		lower    string
+		upper    string
+	}{
+		{"initialism", "HTTPServer", "httpServer", "HTTPServer"},
+		{"lower initialism", "idNumber", "idNumber", "IDNumber"},
+		{"camel", "userName", "userName", "UserName"},
+		{"pascal", "UserName", "userName", "UserName"},
+		{"keeps rest", "User_API-key", "user_API-key", "User_API-key"},
+		{"leading separators", "__init__", "__init__", "__Init__"},
+		{"empty", "", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scenario, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(test.lower, stringcases.LowerFirst(test.text))
+			assert.Equal(test.upper, stringcases.UpperFirst(test.text))
+		})
+	}
+
+	t.Run("options", func(t *testing.T) {
+		tests := []struct {
+			scenario string
+			opt      stringcases.Option
+			text     string
+			lower    string
+			upper    string
+		}{
+			{"placeholder", stringcases.WithSymbolPlaceholder("at"), "@User", "@user", "@User"},
+			{"abbreviations", stringcases.WithAbbreviations(map[string]string{"configuration": "cfg"}), "configurationNumber", "configurationNumber", "ConfigurationNumber"},
+			{"expansions", stringcases.WithExpansions(map[string]string{"tz": "time zone"}), "TzName", "tzName", "TzName"},
+			{"trimmed prefix", stringcases.WithTrimPrefixes("tbl_"), "tbl_UserName", "tbl_userName", "tbl_UserName"},
+			{"normalization", stringcases.WithNormalization(norm.NFC), "Cafe\u0301Menu", "cafe\u0301Menu", "Cafe\u0301Menu"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.scenario, func(t *testing.T) {
+				assert := assert.New(t)
+
+				str := stringcases.New(language.English, test.opt)
+				assert.Equal(test.lower, str.LowerFirst(test.text))
+				assert.Equal(test.upper, str.UpperFirst(test.text))
+			})
+		}
+	})
+}
+
+func TestInvisibleCharacters(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"zero-width space", "user\u200bName", "user_name"},
+		{"zero-width joiner", "us\u200der_id", "user_id"},
+		{"zero-width non-joiner", "user\u200cid", "userid"},
+		{"byte order mark", "\ufeffuserID", "user_id"},
+		{"soft hyphen", "config\u00aduration", "configuration"},
+		{"left-to-right mark", "user\u200e_id", "user_id"},
+		{"control", "user\x00\x1bid", "userid"},
+		{"tab is whitespace", "user\tid", "user_id"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.ToSnake(test.text))
+		})
+	}
+}
+
+func TestTurkish(t *testing.T) {
+	tr := stringcases.New(language.Turkish)
+	ascii := stringcases.New(language.Turkish, stringcases.WithASCIICase())
+
+	tests := []struct {
+		name      string
+		fn        func(string) string
+		asciiFn   func(string) string
+		in        string
+		want      string
+		wantASCII string
+	}{
+		{"snake", tr.ToSnake, ascii.ToSnake, "userID", "user_ıd", "user_id"},
+		{"kebab", tr.ToKebab, ascii.ToKebab, "IşıkID", "ışık-ıd", "işık-id"},
+		{"camel", tr.ToCamel, ascii.ToCamel, "user_id", "userİd", "userID"},
+		{"pascal", tr.ToPascal, ascii.ToPascal, "istanbul_ılık", "İstanbulIlık", "IstanbulIlık"},
+		{"screaming snake", tr.ToScreamingSnake, ascii.ToScreamingSnake, "istanbul ılık", "İSTANBUL_ILIK", "ISTANBUL_ILIK"},
+		{"human", tr.ToHuman, ascii.ToHuman, "user_ID", "User", "User"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tc.want, tc.fn(tc.in))
+			assert.Equal(tc.wantASCII, tc.asciiFn(tc.in))
+		})
+	}
+}
+
+func TestGreekFinalSigma(t *testing.T) {
+	str := stringcases.New(language.Greek)
+
+	tests := []struct {
+		name string
+		fn   func(string) string
+		in   string
+		want string
+	}{
+		{"snake", str.ToSnake, "ΟΔΟΣ_ΟΝΟΜΑ", "οδος_ονομα"},
+		{"snake sigma inside word", str.ToSnake, "ΣΟΦΙΑ", "σοφια"},
+		{"camel", str.ToCamel, "ΟΔΟΣ ΟΝΟΜΑ", "οδοςΟνομα"},
+		{"pascal", str.ToPascal, "οδοσ_αριθμοσ", "ΟδοςΑριθμος"},
+		{"kebab from camel", str.ToKebab, "οδοςΑριθμός", "οδος-αριθμός"},
+		{"title", str.ToTitle, "ΛΟΓΟΣ ΚΑΙ ΠΑΘΟΣ", "Λογος Και Παθος"},
+		{"number suffix", str.ToSnake, "οδοσ2", "οδος2"},
+		{"single letter", str.ToSnake, "Σ", "σ"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.fn(tc.in))
+		})
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"userAPIKey", []string{"user", "API", "Key"}},
+		{"HTTPServer", []string{"HTTP", "Server"}},
+		{"snake_case-and kebab", []string{"snake", "case", "and", "kebab"}},
+		{"", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			assert.Equal(t, test.want, stringcases.Tokenize(test.text))
+		})
+	}
+
+	t.Run("options", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithDelimiterRuns())
+		assert.Equal(t, []string{"a", "", "b"}, str.Tokenize("a__b"))
+	})
+}
+
+func TestWords(t *testing.T) {
+	t.Run("all", func(t *testing.T) {
+		var words []string
+		for w := range stringcases.Words("userAPIKey") {
+			words = append(words, w)
+		}
+		assert.Equal(t, []string{"user", "API", "Key"}, words)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		var words []string
+		for w := range stringcases.Words("one two three four") {
+			if w == "three" {
+				break
+			}
+			words = append(words, w)
+		}
+		assert.Equal(t, []string{"one", "two"}, words)
+	})
+
+	t.Run("break inside expansion", func(t *testing.T) {
+		str := stringcases.New(language.English,
+			stringcases.WithDelimiterRuns(),
+			stringcases.WithExpansions(map[string]string{"tz": "time zone"}),
+		)
+
+		var words []string
+		for w := range str.Words("a__tz_b") {
+			words = append(words, w)
+			if w == "time" {
+				break
+			}
+		}
+		assert.Equal(t, []string{"a", "", "time"}, words)
+	})
+}
+
+func TestWordCount(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(0, stringcases.WordCount(""))
+	assert.Equal(0, stringcases.WordCount("__--"))
+	assert.Equal(3, stringcases.WordCount("userAPIKey"))
+	assert.Equal(4, stringcases.WordCount("the quick-brown_fox"))
+
+	str := stringcases.New(language.English,
+		stringcases.WithDelimiterRuns(),
+		stringcases.WithExpansions(map[string]string{"tz": "time zone"}),
+	)
+	assert.Equal(4, str.WordCount("a__tz_b"))
+}
+
+func TestTokenizeSpans(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		assert.Equal(t, []stringcases.Span{
+			{Word: "user", Start: 0, End: 4},
+			{Word: "API", Start: 4, End: 7},
+			{Word: "Key", Start: 8, End: 11},
+		}, stringcases.TokenizeSpans("userAPI_Key"))
+	})
+
+	t.Run("multibyte", func(t *testing.T) {
+		s := "naïve café"
+		for _, span := range stringcases.TokenizeSpans(s) {
+			assert.Equal(t, span.Word, s[span.Start:span.End])
+		}
+	})
+
+	t.Run("trimmed prefix", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithTrimPrefixes("tbl_"))
+		assert.Equal(t, []stringcases.Span{
+			{Word: "user", Start: 4, End: 8},
+			{Word: "accounts", Start: 9, End: 17},
+		}, str.TokenizeSpans("tbl_user_accounts"))
+	})
+
+	t.Run("units", func(t *testing.T) {
+		s := "max10MBUpload"
+		str := stringcases.New(language.English, stringcases.WithNumberPolicy(stringcases.NumberUnits))
+		for _, span := range str.TokenizeSpans(s) {
+			assert.Equal(t, span.Word, s[span.Start:span.End])
+		}
+	})
+
+	t.Run("expansions", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithExpansions(map[string]string{"tz": "time zone"}))
+		assert.Equal(t, []stringcases.Span{
+			{Word: "user", Start: 0, End: 4},
+			{Word: "time", Start: 4, End: 6},
+			{Word: "zone", Start: 4, End: 6},
+		}, str.TokenizeSpans("userTZ"))
+	})
+}
+
+func TestASCIICasing(t *testing.T) {
+	title := stringcases.Format{First: stringcases.WordTitle, Rest: stringcases.WordTitle, Initialisms: stringcases.NoInitialisms}
+	upper := stringcases.Format{First: stringcases.WordUpper, Rest: stringcases.WordUpper}
+
+	for _, tag := range []language.Tag{language.English, language.German, language.Turkish, language.Dutch} {
+		str := stringcases.New(tag)
+		for _, w := range []string{"user", "Http", "2fa", "x2y", "1st", "abc123", "ijssel", "id", "i"} {
+			assert.Equal(t, cases.Title(tag).String(w), str.Formatter(title)(w), "%s %s", tag, w)
+			assert.Equal(t, cases.Upper(tag).String(w), str.Formatter(upper)(w), "%s %s", tag, w)
+			assert.Equal(t, cases.Lower(tag).String(w), str.ToNoCase(w), "%s %s", tag, w)
+		}
+	}
+}
+
+func TestASCIIScan(t *testing.T) {
+	// A token hook turns off the ASCII scanner, so the words must be the
+	// same with and without one.
+	identity := stringcases.WithTokenHook(func(_ int, w string) string { return w })
+
+	options := map[string][]stringcases.Option{
+		"default":           nil,
+		"number split":      {stringcases.WithNumberPolicy(stringcases.NumberSplit)},
+		"number glue":       {stringcases.WithNumberPolicy(stringcases.NumberGlue)},
+		"glue initialism":   {stringcases.WithNumberPolicy(stringcases.NumberGlueInitialism)},
+		"last upper":        {stringcases.WithAcronymBoundary(stringcases.AcronymBoundaryLastUpper)},
+		"uppercase acronym": {stringcases.WithUppercaseAcronyms()},
+		"roman numerals":    {stringcases.WithRomanNumerals()},
+		"leading":           {stringcases.WithLeadingInitialism(stringcases.LeadingLowerFirst)},
+		"versions":          {stringcases.WithVersionTokens(), stringcases.WithSpecialWords("OAuth", "iOS")},
+		"max words":         {stringcases.WithMaxWords(3)},
+		"no initialisms":    {stringcases.WithoutInitialisms()},
+	}
+
+	words := []string{"user", "User", "USER", "ID", "IDs", "Id", "id", "HTTP", "HTTPS", "API", "APIs", "s", "S",
+		"2", "42", "v1", "V2", "OAuth", "ios", "IPv6", "VIII", "NASA", "x", "X", "_", "-", " ", ".", "1.2", "key"}
+	rng := rand.New(rand.NewPCG(1, 2))
+	inputs := []string{"", "userAccountHTTPSettingsID", "HenryVIIIPortrait", "APIkey", "sHTTPb", "http2Server"}
+	for range 2000 {
+		var sb strings.Builder
+		for range rng.IntN(6) + 1 {
+			sb.WriteString(words[rng.IntN(len(words))])
+		}
+		inputs = append(inputs, sb.String())
+	}
+
+	formats := []stringcases.Format{
+		{Separator: "_"},
+		{Separator: "-", First: stringcases.WordUpper, Rest: stringcases.WordUpper},
+		{First: stringcases.WordLower, Rest: stringcases.WordTitle, Initialisms: stringcases.TitleInitialisms},
+		{First: stringcases.WordTitle, Rest: stringcases.WordTitle, Initialisms: stringcases.TitleInitialisms},
+		{Separator: " ", First: stringcases.WordTitle, Rest: stringcases.WordLower, Initialisms: stringcases.AllInitialisms},
+	}
+
+	for name, opts := range options {
+		t.Run(name, func(t *testing.T) {
+			fast := stringcases.New(language.English, opts...)
+			slow := stringcases.New(language.English, append(opts, identity)...)
+			for _, f := range formats {
+				for _, s := range inputs {
+					if !assert.Equal(t, slow.Formatter(f)(s), fast.Formatter(f)(s), "%q %+v", s, f) {
+						return
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("allocations", func(t *testing.T) {
+		assert := assert.New(t)
+
+		buf := make([]byte, 0, 64)
+		assert.Zero(testing.AllocsPerRun(100, func() {
+			buf = stringcases.AppendSnake(buf[:0], "userAccountHTTPSettingsID")
+		}))
+		assert.Zero(testing.AllocsPerRun(100, func() {
+			buf = stringcases.AppendPascal(buf[:0], "user_account_http_settings_id")
+		}))
+		assert.Equal(1.0, testing.AllocsPerRun(100, func() {
+			stringcases.ToSnake("userAccountHTTPSettingsID")
+		}))
+	})
+}
+
+func BenchmarkToSnake(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		stringcases.ToSnake("userAccountHTTPSettingsID")
+	}
+}
+
+func BenchmarkToPascal(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		stringcases.ToPascal("user_account_http_settings_id")
+	}
+}
+
+func BenchmarkToPascalUnicode(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		stringcases.ToPascal("usér_açcount_settings_ïd")
+	}
+}
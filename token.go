@@ -0,0 +1,90 @@
+package stringcases
+
+import "unicode/utf8"
+
+var Tokens = s.Tokens
+
+// TokenKind classifies a Token.
+type TokenKind int
+
+const (
+	Word TokenKind = iota
+	Acronym
+	Number
+	Version
+	Separator
+)
+
+func (k TokenKind) String() string {
+	switch k {
+	case Word:
+		return "word"
+	case Acronym:
+		return "acronym"
+	case Number:
+		return "number"
+	case Version:
+		return "version"
+	case Separator:
+		return "separator"
+	default:
+		return "unknown"
+	}
+}
+
+// Token is a word or a run of separators, see Tokens.
+type Token struct {
+	Value string
+	Kind  TokenKind
+}
+
+// Tokens splits the string like Tokenize, and classifies the words, so
+// formatters can treat them differently, e.g. "userAPIKey_v2" becomes
+//
+//	[{user word} {API acronym} {Key word} {_ separator} {v2 version}]
+//
+// with WithVersionTokens. Initialisms are acronyms, and numbers include
+// the matches of NumberUnits and WithNumericPatterns. The separators between
+// words are kept as they appear in s, while those before the first word and
+// after the last word are left out.
+func (str *String) Tokens(s string) []Token {
+	var tokens []Token
+	var last int
+	str.scan(s, func(token string, start, end int, r rule) bool {
+		if token == "" {
+			return true
+		}
+
+		if len(tokens) > 0 && last < start && start <= len(s) {
+			tokens = append(tokens, Token{Value: s[last:start], Kind: Separator})
+		}
+		last = max(last, end)
+		tokens = append(tokens, Token{Value: token, Kind: str.kind(token, r)})
+
+		return true
+	})
+
+	return tokens
+}
+
+func (str *String) kind(token string, r rule) TokenKind {
+	switch r {
+	case ruleInitialism:
+		return Acronym
+	case ruleNumber, ruleNumericPattern, ruleUnit:
+		return Number
+	case ruleVersion:
+		return Version
+	case ruleTokenizer, ruleExpansion, ruleAbbreviation:
+		// These words did not come from the input as they are, so they are
+		// classified by their content.
+		if str.upperRule(token) == ruleInitialism {
+			return Acronym
+		}
+		if r, _ := utf8.DecodeRuneInString(token); str.isNumber(r) {
+			return Number
+		}
+	}
+
+	return Word
+}
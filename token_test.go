@@ -0,0 +1,62 @@
+package stringcases_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alextanhongpin/stringcases"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestTokens(t *testing.T) {
+	type token = stringcases.Token
+
+	t.Run("default", func(t *testing.T) {
+		assert.Equal(t, []token{
+			{Value: "user", Kind: stringcases.Word},
+			{Value: "IDs", Kind: stringcases.Acronym},
+			{Value: " - ", Kind: stringcases.Separator},
+			{Value: "2", Kind: stringcases.Number},
+			{Value: "_", Kind: stringcases.Separator},
+			{Value: "HTTP", Kind: stringcases.Acronym},
+			{Value: "Server", Kind: stringcases.Word},
+		}, stringcases.Tokens("__userIDs - 2_HTTPServer__"))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, stringcases.Tokens("__"))
+	})
+
+	t.Run("options", func(t *testing.T) {
+		str := stringcases.New(language.English,
+			stringcases.WithVersionTokens(),
+			stringcases.WithNumberPolicy(stringcases.NumberUnits),
+			stringcases.WithDelimiterRuns(),
+		)
+
+		assert.Equal(t, []token{
+			{Value: "api", Kind: stringcases.Word},
+			{Value: "V2", Kind: stringcases.Version},
+			{Value: "__", Kind: stringcases.Separator},
+			{Value: "max", Kind: stringcases.Word},
+			{Value: "10MB", Kind: stringcases.Number},
+		}, str.Tokens("apiV2__max10MB"))
+	})
+
+	t.Run("tokenizer", func(t *testing.T) {
+		str := stringcases.New(language.English, stringcases.WithTokenizer(stringcases.TokenizerFunc(strings.Fields)))
+
+		assert.Equal(t, []token{
+			{Value: "url", Kind: stringcases.Acronym},
+			{Value: " ", Kind: stringcases.Separator},
+			{Value: "42", Kind: stringcases.Number},
+			{Value: " ", Kind: stringcases.Separator},
+			{Value: "name", Kind: stringcases.Word},
+		}, str.Tokens("url 42 name"))
+	})
+
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "acronym", stringcases.Acronym.String())
+	})
+}